@@ -24,6 +24,11 @@ type ICacheAdapter interface {
 	// found=false if the key does not exist.
 	Get(ctx context.Context, key string) (value string, found bool, err error)
 
+	// Increment atomically adds one to the integer stored at key, starting
+	// from zero when it does not exist, sets its time to live and returns the
+	// new value.
+	Increment(ctx context.Context, key string, timeToLive time.Duration) (int64, error)
+
 	// Delete removes the key from the cache (idempotent).
 	Delete(ctx context.Context, key string) error
 }
@@ -0,0 +1,15 @@
+package certificate
+
+import "src/core/validator"
+
+type CertificateConfig struct {
+	ExpiryWarningDays int // certificates expiring within this many days are flagged
+}
+
+var _ validator.IValidable = (*CertificateConfig)(nil)
+
+func (c *CertificateConfig) Validate() error {
+	return validator.Object(c,
+		validator.Number(&c.ExpiryWarningDays).Integer().Min(1).Default(30),
+	).Validate()
+}
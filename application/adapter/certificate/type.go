@@ -0,0 +1,48 @@
+package certificate
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrCertificate_Invalid           = errors.New("certificate: file is not a readable PKCS#12 container")
+	ErrCertificate_IncorrectPassword = errors.New("certificate: incorrect password")
+	ErrCertificate_InvalidSignature  = errors.New("certificate: signature is not a readable detached CMS structure")
+	ErrCertificate_SignatureMismatch = errors.New("certificate: signature does not match the content")
+)
+
+// CertificateInfo describes the end-entity certificate of a container.
+type CertificateInfo struct {
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serial_number"`
+	Fingerprint  string    `json:"fingerprint"` // hex SHA-256 of the DER certificate
+	NotBefore    time.Time `json:"not_before"`
+	NotAfter     time.Time `json:"not_after"`
+}
+
+// Signature is a detached CMS (PKCS #7) SignedData over some content.
+type Signature struct {
+	Content  []byte          // DER encoded SignedData, usually stored as .p7s
+	Digest   string          // hex SHA-256 of the signed content
+	SignedAt time.Time       // signingTime attribute
+	Signer   CertificateInfo // certificate embedded for the signer
+}
+
+type ICertificateAdapter interface {
+	Config() *CertificateConfig
+
+	// ParsePKCS12 opens a PKCS#12 (.pfx/.p12) file and describes the
+	// certificate that matches its private key.
+	ParsePKCS12(content []byte, password string) (*CertificateInfo, error)
+
+	// SignDetached signs content with the key of a PKCS#12 container and
+	// returns a detached CMS signature that embeds the signer's chain.
+	SignDetached(content io.Reader, container []byte, password string) (*Signature, error)
+
+	// VerifyDetached checks a signature produced by SignDetached against
+	// content. It does not validate the chain of trust.
+	VerifyDetached(content io.Reader, signature []byte) (*Signature, error)
+}
@@ -8,11 +8,19 @@ type ICryptoAdapter interface {
 	// Não faz persistência nem validação, apenas gera o valor.
 	OTP() string
 
-	// Hash gera um hash não reversível do texto em claro.
-	// Deve ser usado para senhas ou dados sensíveis onde apenas a comparação é necessária.
-	// A implementação é responsável por usar algoritmo, salt e custo adequados.
+	// Hash gera um hash determinístico e não reversível do texto em claro.
+	// Deve ser usado para tokens aleatórios guardados apenas como hash, que são
+	// buscados pelo próprio hash. Não deve ser usado para senhas (ver HashPassword).
 	Hash(plainText string) string
 
+	// HashPassword gera um hash de senha com salt aleatório e custo adequado.
+	// Dois hashes da mesma senha são diferentes; a comparação é feita por VerifyPassword.
+	HashPassword(plainText string) string
+
+	// VerifyPassword informa, em tempo constante, se plainText corresponde a um
+	// hash gerado por HashPassword. Um hash inválido nunca corresponde.
+	VerifyPassword(plainText string, hash string) bool
+
 	// Encrypt serializa e criptografa o valor informado.
 	//
 	// - plainText pode ser qualquer valor serializável (string, struct, map, etc.).
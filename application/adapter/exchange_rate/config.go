@@ -0,0 +1,18 @@
+package exchange_rate
+
+import "src/core/validator"
+
+type ExchangeRateConfig struct {
+	// ECBSource is a file path or http(s) URL in the ECB eurofxref XML format.
+	ECBSource         string
+	PivotCurrencyCode string
+}
+
+var _ validator.IValidable = (*ExchangeRateConfig)(nil)
+
+func (c *ExchangeRateConfig) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.ECBSource).Required().Default("https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"),
+		validator.String(&c.PivotCurrencyCode).Required().Length(3).Default("EUR"),
+	).Validate()
+}
@@ -0,0 +1,29 @@
+package exchange_rate
+
+import (
+	"context"
+	"time"
+
+	"src/domain/entity"
+)
+
+// ProvidedRate is a reference rate published by an external provider:
+// one BaseCurrencyCode buys Rate units of QuoteCurrencyCode.
+type ProvidedRate struct {
+	BaseCurrencyCode  string
+	QuoteCurrencyCode string
+	Rate              entity.Rate
+	EffectiveAt       time.Time
+}
+
+// IExchangeRateProvider fetches reference rates from an external source.
+// Concrete implementations are in infra/exchange_rate/*.
+type IExchangeRateProvider interface {
+	Config() *ExchangeRateConfig
+
+	// Name identifies the provider in logs and results.
+	Name() string
+
+	// Fetch returns every rate available at the source.
+	Fetch(ctx context.Context) ([]ProvidedRate, error)
+}
@@ -12,8 +12,10 @@ type JwtConfig struct {
 	Issuer     string
 	AccessTTL  string // ex: "15m"
 	RefreshTTL string // ex: "30d"
-	PrivateKey string // chave privada (PEM)
-	PublicKey  string // chave pública (PEM)
+	// ImpersonationTTL é a duração dos tokens de impersonação, ex: "10m"
+	ImpersonationTTL string
+	PrivateKey       string // chave privada (PEM)
+	PublicKey        string // chave pública (PEM)
 }
 
 var _ validator.IValidable = (*JwtConfig)(nil)
@@ -25,6 +27,7 @@ func (c *JwtConfig) Validate() error {
 		validator.String(&c.Issuer).Required(),
 		validator.String(&c.AccessTTL).Required().Default("15m"),
 		validator.String(&c.RefreshTTL).Required().Default("30d"),
+		validator.String(&c.ImpersonationTTL).Required().Default("10m"),
 		validator.String(&c.PrivateKey).Required(),
 		validator.String(&c.PublicKey).Required(),
 	).Validate()
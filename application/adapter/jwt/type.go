@@ -13,6 +13,13 @@ type OpenIDInfo struct {
 	Picture    string `json:"picture"`
 	Theme      string `json:"theme"`
 	Timezone   string `json:"timezone"`
+	Actor      *Actor `json:"act,omitempty"` // só em tokens de impersonação
+}
+
+// Actor identifica quem realmente usa um token emitido em nome do subject
+// (claim "act" da RFC 8693).
+type Actor struct {
+	Subject string `json:"sub"`
 }
 
 // OpenIDToken representa o token emitido pela aplicação.
@@ -38,6 +45,8 @@ type DecodedToken struct {
 type IJwtAdapter interface {
 	// Create gera um par de tokens (access e, opcionalmente, refresh).
 	// complete=true indica que o refresh_token também deve ser gerado.
+	// Com info.Actor preenchido o token é de impersonação: dura ImpersonationTTL
+	// e nunca tem refresh_token.
 	Create(ctx context.Context, sessionKey string, info *OpenIDInfo, complete bool) (OpenIDToken, error)
 
 	// Decode valida o token (assinatura, issuer, audience, etc.) e devolve
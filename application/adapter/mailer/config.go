@@ -10,6 +10,7 @@ type MailerConfig struct {
 	SMTPIgnoreTLS bool
 	SMTPUsername  string
 	SMTPPassword  string
+	LinkBaseURL   string // public address of the web app that links in emails point to
 }
 
 var _ validator.IValidable = (*MailerConfig)(nil)
@@ -23,5 +24,6 @@ func (c *MailerConfig) Validate() error {
 		validator.Boolean(&c.SMTPIgnoreTLS).Default(false),
 		validator.String(&c.SMTPUsername).Required(),
 		validator.String(&c.SMTPPassword).Required(),
+		validator.String(&c.LinkBaseURL).Trim().Required().URI().Default("http://localhost:4000"),
 	).Validate()
 }
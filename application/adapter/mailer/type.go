@@ -26,6 +26,8 @@ type MailPayload struct {
 
 // IMailerAdapter defines the generic contract for email sending.
 type IMailerAdapter interface {
+	Config() *MailerConfig
+
 	// Send sends an email based on the provided input.
 	Send(ctx context.Context, input MailPayload) error
 }
@@ -0,0 +1,13 @@
+package metrics
+
+import "io"
+
+// IMetricsAdapter records application metrics and exposes them to a
+// scraper. Concrete implementations are in infra/metrics/*.
+type IMetricsAdapter interface {
+	// SetGauge records the current value of a gauge, creating it on first use.
+	SetGauge(name string, help string, value float64)
+
+	// Write renders every metric in the Prometheus text exposition format.
+	Write(w io.Writer) error
+}
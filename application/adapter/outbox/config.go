@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"time"
+
+	"src/core/validator"
+)
+
+type OutboxConfig struct {
+	PollInterval   time.Duration // wait between polls when the outbox is drained
+	BatchSize      int           // messages claimed per poll
+	PublishTimeout time.Duration // limit for publishing one message, its batch stays locked meanwhile
+	MinBackoff     time.Duration // delay before the first retry, doubled on every attempt
+	MaxBackoff     time.Duration
+	Retention      time.Duration // sent messages older than this are pruned
+	PruneInterval  time.Duration // also how often the outbox lag is reported
+	LagWarning     time.Duration // lag above which the report is a warning
+}
+
+var _ validator.IValidable = (*OutboxConfig)(nil)
+
+func (c *OutboxConfig) Validate() error {
+	return validator.Object(c,
+		validator.Number(&c.PollInterval).Integer().Positive().Default(float64(time.Second)),
+		validator.Number(&c.BatchSize).Integer().Min(1).Max(1000).Default(100),
+		validator.Number(&c.PublishTimeout).Integer().Positive().Default(float64(10*time.Second)),
+		validator.Number(&c.MinBackoff).Integer().Positive().Default(float64(time.Second)),
+		validator.Number(&c.MaxBackoff).Integer().Positive().Default(float64(5*time.Minute)),
+		validator.Number(&c.Retention).Integer().Positive().Default(float64(7*24*time.Hour)),
+		validator.Number(&c.PruneInterval).Integer().Positive().Default(float64(time.Minute)),
+		validator.Number(&c.LagWarning).Integer().Positive().Default(float64(time.Minute)),
+	).Validate()
+}
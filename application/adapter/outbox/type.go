@@ -0,0 +1,9 @@
+package outbox
+
+import "context"
+
+// IOutboxRelay publishes the messages written to the outbox to the stream.
+type IOutboxRelay interface {
+	// Run relays until ctx is done; several replicas may run it at once.
+	Run(ctx context.Context) error
+}
@@ -0,0 +1,33 @@
+package password
+
+import "src/core/validator"
+
+type PasswordConfig struct {
+	MinLength        int
+	MaxLength        int
+	RequireLowercase bool
+	RequireUppercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	ForbidPersonal   bool   // reject passwords containing the account email or name
+	Screening        string // "bundled", "range" or "none"
+	RangeURL         string // k-anonymity range endpoint, the 5 hex prefix is appended, ex: "https://api.pwnedpasswords.com/range/"
+	Timeout          int    // seconds allowed for a range lookup
+}
+
+var _ validator.IValidable = (*PasswordConfig)(nil)
+
+func (c *PasswordConfig) Validate() error {
+	return validator.Object(c,
+		validator.Number(&c.MinLength).Integer().Min(1).Default(10),
+		validator.Number(&c.MaxLength).Integer().Min(1).Default(128),
+		validator.Boolean(&c.RequireLowercase).Default(false),
+		validator.Boolean(&c.RequireUppercase).Default(false),
+		validator.Boolean(&c.RequireDigit).Default(false),
+		validator.Boolean(&c.RequireSymbol).Default(false),
+		validator.Boolean(&c.ForbidPersonal).Default(false),
+		validator.String(&c.Screening).Trim().Lowercase().Default("bundled").Allow("bundled", "range", "none"),
+		validator.String(&c.RangeURL).Trim().URI(),
+		validator.Number(&c.Timeout).Integer().Min(1).Max(30).Default(3),
+	).Validate()
+}
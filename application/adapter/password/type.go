@@ -0,0 +1,29 @@
+package password
+
+import (
+	"context"
+	"errors"
+
+	"src/core/validator"
+)
+
+var ErrPassword_RangeUnavailable = errors.New("password range lookup unavailable")
+
+// IPasswordAdapter holds the password policy and screens passwords against
+// known leaked or common ones. Screening uses k-anonymity: only the first 5
+// hex characters of the password SHA-1 leave the process.
+type IPasswordAdapter interface {
+	Config() *PasswordConfig
+
+	// Policy returns the configured policy for validator.String(...).Password,
+	// forbidding the personal values given (email, name, ...) and screening
+	// with IsBreached. A failed lookup does not reject the password.
+	Policy(ctx context.Context, personal ...string) validator.PasswordPolicy
+
+	// IsBreached reports whether password appears in the screening list.
+	IsBreached(ctx context.Context, password string) (bool, error)
+
+	// Range returns the SHA-1 suffixes (35 upper hex characters) known for a
+	// 5 character prefix, with how often each was seen.
+	Range(ctx context.Context, prefix string) (map[string]int, error)
+}
@@ -0,0 +1,23 @@
+package realtime
+
+import "src/core/validator"
+
+type RealtimeConfig struct {
+	Topic                    string // stream topic shared by every API replica
+	ReplayWindow             string // ex: "10m", how far back Last-Event-ID can resume
+	ReplaySize               int    // messages kept per account for resuming
+	HeartbeatInterval        string // ex: "15s"
+	MaxConnectionsPerAccount int
+}
+
+var _ validator.IValidable = (*RealtimeConfig)(nil)
+
+func (c *RealtimeConfig) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Topic).Required().Default("control_plane.realtime"),
+		validator.String(&c.ReplayWindow).Required().Default("10m"),
+		validator.Number(&c.ReplaySize).Integer().Min(0).Default(100),
+		validator.String(&c.HeartbeatInterval).Required().Default("15s"),
+		validator.Number(&c.MaxConnectionsPerAccount).Integer().Min(1).Default(5),
+	).Validate()
+}
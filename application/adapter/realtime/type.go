@@ -0,0 +1,40 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	Event_Notification   = "notification"
+	Event_SessionRevoked = "session_revoked"
+)
+
+var ErrRealtime_ConnectionLimit = errors.New("realtime: too many open connections for this account")
+
+// Message is pushed to every live connection of AccountID. IDs are time
+// ordered so clients can resume with the last one they received.
+type Message struct {
+	ID        string          `json:"id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Event     string          `json:"event"`
+	Data      json.RawMessage `json:"data"`
+	AccountID uuid.UUID       `json:"account_id"`
+}
+
+type IRealtimeAdapter interface {
+	Config() *RealtimeConfig
+
+	// Publish fans the message out to the connections of its account on every
+	// replica. ID and CreatedAt are filled in when empty.
+	Publish(ctx context.Context, message Message) error
+
+	// Subscribe returns the messages of an account, starting with the ones
+	// published after lastEventID when it is still in the replay window. The
+	// channel is closed when ctx is done or the subscriber falls behind.
+	Subscribe(ctx context.Context, accountID uuid.UUID, lastEventID string) (<-chan Message, error)
+}
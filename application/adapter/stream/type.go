@@ -15,5 +15,8 @@ type Payload struct {
 type IStreamAdapter interface {
 	Ping(ctx context.Context) error
 	Publish(ctx context.Context, topic string, payload Payload) error
-	Subscribe(ctx context.Context, topic string, handler func(payload Payload) error) error
+	// Subscribe delivers the messages of topic to handler. Subscribers of the
+	// same group share the messages, each one reaching a single member; an
+	// empty group receives every message published after it subscribed.
+	Subscribe(ctx context.Context, topic string, group string, handler func(payload Payload) error) error
 }
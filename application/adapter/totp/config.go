@@ -0,0 +1,21 @@
+package totp
+
+import "src/core/validator"
+
+type TotpConfig struct {
+	Issuer string // shown by authenticator apps next to the account
+	Digits int
+	Period int // seconds per time step
+	Skew   int // steps accepted before and after the current one
+}
+
+var _ validator.IValidable = (*TotpConfig)(nil)
+
+func (c *TotpConfig) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Issuer).Required().Default("Control Plane"),
+		validator.Number(&c.Digits).Integer().Min(6).Max(8).Default(6),
+		validator.Number(&c.Period).Integer().Min(15).Max(120).Default(30),
+		validator.Number(&c.Skew).Integer().Min(0).Max(3).Default(1),
+	).Validate()
+}
@@ -0,0 +1,19 @@
+package totp
+
+import "time"
+
+// ITotpAdapter implements RFC 6238 time-based one-time passwords.
+type ITotpAdapter interface {
+	Config() *TotpConfig
+
+	// GenerateSecret returns a new random shared secret, base32 encoded.
+	GenerateSecret() (string, error)
+
+	// URI builds the otpauth:// key URI that authenticator apps read from a
+	// QR code.
+	URI(secret string, accountName string) string
+
+	// Validate checks code against secret at the given instant and returns
+	// the time step it matched, so callers can refuse replays of that step.
+	Validate(secret string, code string, at time.Time) (int64, bool)
+}
@@ -0,0 +1,23 @@
+package webauthn
+
+import "src/core/validator"
+
+type WebAuthnConfig struct {
+	RelyingPartyID   string // effective domain passkeys are scoped to, ex: "example.com"
+	RelyingPartyName string
+	Origins          string // comma separated origins allowed in client data
+	Timeout          int    // seconds a ceremony stays open
+	UserVerification string // "required", "preferred" or "discouraged"
+}
+
+var _ validator.IValidable = (*WebAuthnConfig)(nil)
+
+func (c *WebAuthnConfig) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.RelyingPartyID).Trim().Lowercase().Required().Default("localhost"),
+		validator.String(&c.RelyingPartyName).Required().Default("Control Plane"),
+		validator.String(&c.Origins).Trim().Required().Default("http://localhost:4000"),
+		validator.Number(&c.Timeout).Integer().Min(30).Max(600).Default(300),
+		validator.String(&c.UserVerification).Trim().Lowercase().Default("preferred").Allow("required", "preferred", "discouraged"),
+	).Validate()
+}
@@ -0,0 +1,124 @@
+package webauthn
+
+import "errors"
+
+var (
+	ErrWebAuthn_InvalidClientData      = errors.New("webauthn: client data is malformed or of the wrong ceremony")
+	ErrWebAuthn_ChallengeMismatch      = errors.New("webauthn: challenge does not match the one issued")
+	ErrWebAuthn_OriginMismatch         = errors.New("webauthn: origin is not allowed")
+	ErrWebAuthn_InvalidAuthenticator   = errors.New("webauthn: authenticator data is malformed")
+	ErrWebAuthn_RelyingPartyMismatch   = errors.New("webauthn: authenticator data is scoped to another relying party")
+	ErrWebAuthn_UserNotPresent         = errors.New("webauthn: user presence was not asserted")
+	ErrWebAuthn_UserNotVerified        = errors.New("webauthn: user verification is required")
+	ErrWebAuthn_UnsupportedAttestation = errors.New("webauthn: attestation format is not supported")
+	ErrWebAuthn_InvalidAttestation     = errors.New("webauthn: attestation statement does not verify")
+	ErrWebAuthn_UnsupportedKey         = errors.New("webauthn: credential public key type is not supported")
+	ErrWebAuthn_InvalidSignature       = errors.New("webauthn: assertion signature does not verify")
+	ErrWebAuthn_SignCountRegression    = errors.New("webauthn: signature counter did not increase, the authenticator may be cloned")
+)
+
+// Credential is a public key credential accepted by a registration ceremony.
+type Credential struct {
+	ID                []byte
+	PublicKey         []byte // COSE_Key as sent by the authenticator
+	SignCount         uint32
+	Aaguid            string // authenticator model, all zeros when not attested
+	AttestationFormat string // "none" or "packed"
+	UserVerified      bool
+	BackupEligible    bool // a synced passkey rather than a device-bound key
+}
+
+// Assertion is the outcome of an authentication ceremony.
+type Assertion struct {
+	SignCount    uint32
+	UserVerified bool
+}
+
+// AttestationResponse carries the fields of an AuthenticatorAttestationResponse.
+type AttestationResponse struct {
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// AssertionResponse carries the fields of an AuthenticatorAssertionResponse.
+type AssertionResponse struct {
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+// RelyingParty, User and CredentialDescriptor follow the JSON serialization
+// of the WebAuthn Level 3 options, binary values are base64url without padding.
+type RelyingParty struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type User struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int    `json:"alg"`
+}
+
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// CreationOptions is what navigator.credentials.create() expects as publicKey.
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RelyingParty           RelyingParty           `json:"rp"`
+	User                   User                   `json:"user"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int                    `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions is what navigator.credentials.get() expects as publicKey.
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RelyingPartyID   string                 `json:"rpId"`
+	Timeout          int                    `json:"timeout"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// IWebAuthnAdapter runs the relying party side of WebAuthn ceremonies.
+// Verification only depends on its inputs, so recorded authenticator
+// responses can be replayed against it.
+type IWebAuthnAdapter interface {
+	Config() *WebAuthnConfig
+
+	// NewChallenge returns a random challenge, base64url encoded.
+	NewChallenge() (string, error)
+
+	// CreationOptions builds registration options for a user. Credentials
+	// the user already owns are excluded so an authenticator is not enrolled twice.
+	CreationOptions(challenge string, user User, excludeCredentialIDs [][]byte) *CreationOptions
+
+	// RequestOptions builds authentication options. Without credential ids
+	// the authenticator offers its discoverable credentials.
+	RequestOptions(challenge string, allowCredentialIDs [][]byte) *RequestOptions
+
+	// VerifyRegistration checks an attestation against the challenge issued
+	// for it and returns the new credential. Supported formats are "none" and
+	// "packed"; attestation certificates are checked but not chained to a root.
+	VerifyRegistration(challenge string, response *AttestationResponse) (*Credential, error)
+
+	// VerifyAssertion checks an assertion against the challenge and the stored
+	// credential. The counter must grow unless the authenticator never counts.
+	VerifyAssertion(challenge string, publicKey []byte, signCount uint32, response *AssertionResponse) (*Assertion, error)
+}
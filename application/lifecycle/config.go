@@ -0,0 +1,24 @@
+package lifecycle
+
+import (
+	"time"
+
+	"src/core/validator"
+)
+
+type HostConfig struct {
+	// ShutdownTimeout bounds draining the workers
+	ShutdownTimeout time.Duration
+	// CloseTimeout bounds closing the adapters, which follows the draining
+	// even when it timed out
+	CloseTimeout time.Duration
+}
+
+var _ validator.IValidable = (*HostConfig)(nil)
+
+func (c *HostConfig) Validate() error {
+	return validator.Object(c,
+		validator.Number(&c.ShutdownTimeout).Integer().Positive().Default(float64(30*time.Second)),
+		validator.Number(&c.CloseTimeout).Integer().Positive().Default(float64(10*time.Second)),
+	).Validate()
+}
@@ -0,0 +1,166 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"src/application/adapter/logger"
+	"src/core/di"
+)
+
+// IWorker is a long-running part of the application, such as the HTTP
+// server or a background worker.
+type IWorker interface {
+	// Run works until ctx is done, then stops taking new work and returns
+	// once the work in flight is finished. Returning earlier stops the
+	// application.
+	Run(ctx context.Context) error
+}
+
+// WorkerFunc adapts a function to IWorker.
+type WorkerFunc func(ctx context.Context) error
+
+func (f WorkerFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+type namedWorker struct {
+	name string
+	IWorker
+}
+
+// Host runs the workers of the application and shuts it down on SIGINT or
+// SIGTERM: it stops the workers, waits for them to drain, then closes the
+// adapters held by the container in reverse creation order, so each one is
+// closed before the ones it depends on. The adapters are closed even when
+// the workers did not drain in time.
+type Host struct {
+	config  *HostConfig
+	logger  logger.ILoggerAdapter
+	workers []namedWorker
+}
+
+func NewHost(config *HostConfig, logger logger.ILoggerAdapter) *Host {
+	if err := config.Validate(); err != nil {
+		panic(err)
+	}
+	return &Host{config: config, logger: logger}
+}
+
+// Add registers a worker, started by Run in the order added.
+func (h *Host) Add(name string, worker IWorker) *Host {
+	h.workers = append(h.workers, namedWorker{name: name, IWorker: worker})
+	return h
+}
+
+// Run starts the workers and blocks until a signal arrives, ctx is done or a
+// worker stops on its own, then shuts down within the configured timeouts.
+// It returns the errors of the workers and of the adapters that failed to
+// close, and whether a timeout was exceeded.
+func (h *Host) Run(ctx context.Context) error {
+	signalCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	runCtx, stop := context.WithCancelCause(signalCtx)
+	defer stop(nil)
+
+	var (
+		failed  failures
+		running sync.WaitGroup
+	)
+	for _, worker := range h.workers {
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			h.logger.Info("Worker started", map[string]any{"worker": worker.name})
+
+			err := worker.Run(runCtx)
+			if err != nil {
+				failed.add(fmt.Errorf("lifecycle: worker %s: %w", worker.name, err))
+			}
+			if runCtx.Err() == nil {
+				stop(fmt.Errorf("worker %s stopped", worker.name))
+			}
+
+			optionalMap := map[string]any{"worker": worker.name}
+			if err != nil {
+				optionalMap["err"] = err.Error()
+			}
+			h.logger.Info("Worker stopped", optionalMap)
+		}()
+	}
+
+	<-runCtx.Done()
+	cause := context.Cause(runCtx).Error()
+	if signalCtx.Err() != nil && ctx.Err() == nil {
+		cause = "signal received"
+	}
+	h.logger.Info("Shutting down", map[string]any{
+		"cause":   cause,
+		"timeout": h.config.ShutdownTimeout.String(),
+	})
+
+	drained := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(drained)
+	}()
+	drainDeadline := time.NewTimer(h.config.ShutdownTimeout)
+	defer drainDeadline.Stop()
+	select {
+	case <-drained:
+		h.logger.Info("Workers drained")
+	case <-drainDeadline.C:
+		// the adapters are closed anyway: connections and buffered writes
+		// matter more than the work still stuck in a worker
+		h.logger.Warn("Shutdown timed out while draining the workers")
+		failed.add(errors.New("lifecycle: shutdown timed out while draining the workers"))
+	}
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- di.CloseWithReport(func(instance any, err error) {
+			optionalMap := map[string]any{"adapter": fmt.Sprintf("%T", instance)}
+			if err != nil {
+				optionalMap["err"] = err.Error()
+				h.logger.Error("Adapter not closed", optionalMap)
+				return
+			}
+			h.logger.Info("Adapter closed", optionalMap)
+		})
+	}()
+	closeDeadline := time.NewTimer(h.config.CloseTimeout)
+	defer closeDeadline.Stop()
+	select {
+	case err := <-closed:
+		h.logger.Info("Shutdown complete")
+		return failed.join(err)
+	case <-closeDeadline.C:
+		h.logger.Warn("Shutdown timed out while closing the adapters")
+		return failed.join(errors.New("lifecycle: shutdown timed out while closing the adapters"))
+	}
+}
+
+// failures collects the errors of the workers, which may still be running
+// when a timeout ends the shutdown.
+type failures struct {
+	mutex sync.Mutex
+	errs  []error
+}
+
+func (f *failures) add(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.errs = append(f.errs, err)
+}
+
+func (f *failures) join(last error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return errors.Join(append(f.errs, last)...)
+}
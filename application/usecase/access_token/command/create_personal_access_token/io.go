@@ -0,0 +1,32 @@
+package create_personal_access_token
+
+import (
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/validator"
+)
+
+type Command struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Name).Trim().Required().Max(64),
+		validator.Array(&c.Scopes).Max(32).Unique().Custom(nil, keys.ValidateScopes),
+		validator.Number(&c.ExpiresInDays).Integer().Min(0).Max(3650).Default(90),
+	).Validate()
+}
+
+// Result holds the only copy of the secret, it cannot be read again.
+type Result struct {
+	Key    keys.AccessToken `json:"key"`
+	Secret string           `json:"secret"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "access_token", r.Key.ID.String()
+}
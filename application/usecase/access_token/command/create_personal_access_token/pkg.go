@@ -0,0 +1,31 @@
+package create_personal_access_token
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Name: "CI deploy", Scopes: []string{"document:read"}, ExpiresInDays: 90}
+	meta.Describe(&command,
+		meta.Description("Create a personal access token acting as the caller. Send it as \"Authorization: Bearer <secret>\""),
+		meta.Example(&command),
+		meta.Field(&command.Name, meta.Description("Label to recognize the token by")),
+		meta.Field(&command.Scopes, meta.Description("\"<resource>:read\" or \"<resource>:write\" scopes the token is limited to. Empty grants everything the caller can do")),
+		meta.Field(&command.ExpiresInDays, meta.Description("Lifetime in days, 0 never expires")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_FromAccessToken),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Secret: "cpp_4f9a0c1e2b3d4a5f_0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c"}
+	meta.Describe(&result,
+		meta.Description("The new token"),
+		meta.Field(&result.Key, meta.Description("Token details, as returned by list_personal_access_token")),
+		meta.Field(&result.Secret, meta.Description("The token itself, shown only once")))
+}
@@ -0,0 +1,89 @@
+package create_tenant_api_key
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/crypto"
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "creating an API key requires an authenticated account"
+	Err_FromAccessToken  = "an access token cannot create other access tokens"
+	Err_NotAdmin         = "only an admin of the tenant can create its API keys"
+	Err_Failed           = "API key creation failed"
+)
+
+type Handler struct {
+	cryptoAdapter         crypto.ICryptoAdapter
+	accessTokenRepository repository.IAccessTokenRepository
+	membershipRepository  repository.IMembershipRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	accessTokenRepository repository.IAccessTokenRepository,
+	membershipRepository repository.IMembershipRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:         cryptoAdapter,
+		accessTokenRepository: accessTokenRepository,
+		membershipRepository:  membershipRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+	if info.AccessTokenID != nil {
+		return nil, exception.NewForbidden().WithMessage(Err_FromAccessToken)
+	}
+
+	tenantID := uuid.MustParse(command.TenantID)
+	admin, err := keys.IsTenantAdmin(ctx, h.membershipRepository, *info.AccountID, tenantID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !admin {
+		return nil, exception.NewForbidden().WithMessage(Err_NotAdmin)
+	}
+
+	secret, prefix, err := keys.Generate(entity.AccessTokenKind_Tenant)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	now := time.Now().UTC()
+	token := &entity.AccessTokenEntity{
+		ID:        uuid.New(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: keys.ExpiresAt(now, command.ExpiresInDays),
+		Kind:      string(entity.AccessTokenKind_Tenant),
+		Name:      command.Name,
+		Prefix:    prefix,
+		TokenHash: h.cryptoAdapter.Hash(secret),
+		Scopes:    command.Scopes,
+		AccountID: *info.AccountID,
+		TenantID:  &tenantID,
+	}
+	if err := h.accessTokenRepository.Insert(ctx, token); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Key: keys.NewAccessToken(token), Secret: secret}, nil
+}
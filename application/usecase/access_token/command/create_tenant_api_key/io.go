@@ -0,0 +1,34 @@
+package create_tenant_api_key
+
+import (
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/validator"
+)
+
+type Command struct {
+	TenantID      string   `json:"tenant_id"`
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.TenantID).Trim().Lowercase().Required().GUID(),
+		validator.String(&c.Name).Trim().Required().Max(64),
+		validator.Array(&c.Scopes).Max(32).Unique().Custom(nil, keys.ValidateScopes),
+		validator.Number(&c.ExpiresInDays).Integer().Min(0).Max(3650).Default(365),
+	).Validate()
+}
+
+// Result holds the only copy of the secret, it cannot be read again.
+type Result struct {
+	Key    keys.AccessToken `json:"key"`
+	Secret string           `json:"secret"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "access_token", r.Key.ID.String()
+}
@@ -0,0 +1,38 @@
+package create_tenant_api_key
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{
+		TenantID:      "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50",
+		Name:          "ERP integration",
+		Scopes:        []string{"document:read"},
+		ExpiresInDays: 365,
+	}
+	meta.Describe(&command,
+		meta.Description("Create an API key for a tenant. Requests made with it act within that tenant, on behalf of the admin who created it, and stop working if that admin leaves the tenant"),
+		meta.Example(&command),
+		meta.Field(&command.TenantID, meta.Description("Tenant the key belongs to, the caller must be one of its admins")),
+		meta.Field(&command.Name, meta.Description("Label to recognize the key by")),
+		meta.Field(&command.Scopes, meta.Description("\"<resource>:read\" or \"<resource>:write\" scopes the key is limited to. Empty grants everything the creator can do")),
+		meta.Field(&command.ExpiresInDays, meta.Description("Lifetime in days, 0 never expires")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_FromAccessToken),
+		meta.Throws[exception.Forbidden](Err_NotAdmin),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Secret: "cpk_9c0d1e2f3a4b5c6d_7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d"}
+	meta.Describe(&result,
+		meta.Description("The new API key"),
+		meta.Field(&result.Key, meta.Description("Key details, as returned by list_tenant_api_key")),
+		meta.Field(&result.Secret, meta.Description("The key itself, shown only once")))
+}
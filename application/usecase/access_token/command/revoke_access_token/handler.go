@@ -0,0 +1,82 @@
+package revoke_access_token
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "revoking an access token requires an authenticated account"
+	Err_NotFound         = "access token not found"
+	Err_AlreadyRevoked   = "access token is already revoked"
+	Err_Failed           = "access token revocation failed"
+)
+
+type Handler struct {
+	accessTokenRepository repository.IAccessTokenRepository
+	membershipRepository  repository.IMembershipRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	accessTokenRepository repository.IAccessTokenRepository,
+	membershipRepository repository.IMembershipRepository,
+) *Handler {
+	return &Handler{
+		accessTokenRepository: accessTokenRepository,
+		membershipRepository:  membershipRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	token, err := h.accessTokenRepository.GetByID(ctx, uuid.MustParse(command.AccessTokenID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	// tokens the caller may not manage are reported as missing
+	if token == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_NotFound)
+	}
+	switch entity.AccessTokenKindEnum(token.Kind) {
+	case entity.AccessTokenKind_Personal:
+		if token.AccountID != *info.AccountID {
+			return nil, exception.NewNotFound().WithMessage(Err_NotFound)
+		}
+	case entity.AccessTokenKind_Tenant:
+		admin, err := keys.IsTenantAdmin(ctx, h.membershipRepository, *info.AccountID, *token.TenantID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if !admin {
+			return nil, exception.NewNotFound().WithMessage(Err_NotFound)
+		}
+	}
+
+	revoked, err := h.accessTokenRepository.Revoke(ctx, token.ID, time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if revoked == 0 {
+		return nil, exception.NewConflict().WithMessage(Err_AlreadyRevoked)
+	}
+
+	return &Result{}, nil
+}
@@ -0,0 +1,21 @@
+package revoke_access_token
+
+import "src/core/validator"
+
+type Command struct {
+	AccessTokenID string `json:"access_token_id"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.AccessTokenID).Trim().Lowercase().Required().GUID(),
+	).Validate()
+}
+
+func (c *Command) ActivityTarget() (string, string) {
+	return "access_token", c.AccessTokenID
+}
+
+type Result struct{}
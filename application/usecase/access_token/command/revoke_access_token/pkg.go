@@ -0,0 +1,24 @@
+package revoke_access_token
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{AccessTokenID: "5d2c8e1a-4b3f-4a6e-9c7d-0e1f2a3b4c5d"}
+	meta.Describe(&command,
+		meta.Description("Revoke a personal access token of the caller, or an API key of a tenant the caller administers. It is refused from then on"),
+		meta.Example(&command),
+		meta.Field(&command.AccessTokenID, meta.Description("Token or key to revoke")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotFound),
+		meta.Throws[exception.Conflict](Err_AlreadyRevoked),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,139 @@
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+// Tokens read "<kind>_<lookup>_<secret>". The kind tells secret scanners and
+// people what leaked; "<kind>_<lookup>" is the stored, public prefix.
+const (
+	kindPersonal = "cpp"
+	kindTenant   = "cpk"
+
+	lookupSize = 8
+	secretSize = 24
+)
+
+// ScopePattern accepts scopes such as "document:read" or "notification:write".
+var (
+	ScopePattern    = regexp.MustCompile(`^[a-z][a-z_]*:(read|write)$`)
+	errInvalidScope = errors.New(`scopes must look like "<resource>:read" or "<resource>:write"`)
+)
+
+// AccessToken is the public view of an access token; the secret is never part of it.
+type AccessToken struct {
+	ID         uuid.UUID  `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	Kind       string     `json:"kind"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	TenantID   *uuid.UUID `json:"tenant_id"`
+}
+
+func NewAccessToken(token *entity.AccessTokenEntity) AccessToken {
+	scopes := token.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return AccessToken{
+		ID:         token.ID,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+		Kind:       token.Kind,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     scopes,
+		TenantID:   token.TenantID,
+	}
+}
+
+// Generate returns a new token of the given kind and its public prefix.
+func Generate(kind entity.AccessTokenKindEnum) (token string, prefix string, err error) {
+	random := make([]byte, lookupSize+secretSize)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", err
+	}
+	prefix = kindPersonal + "_" + hex.EncodeToString(random[:lookupSize])
+	if kind == entity.AccessTokenKind_Tenant {
+		prefix = kindTenant + "_" + hex.EncodeToString(random[:lookupSize])
+	}
+	return prefix + "_" + hex.EncodeToString(random[lookupSize:]), prefix, nil
+}
+
+// Prefix returns the public prefix of a well formed token.
+func Prefix(token string) (string, bool) {
+	kind, rest, found := strings.Cut(token, "_")
+	if !found || (kind != kindPersonal && kind != kindTenant) {
+		return "", false
+	}
+	lookup, secret, found := strings.Cut(rest, "_")
+	if !found || len(lookup) != 2*lookupSize || len(secret) != 2*secretSize {
+		return "", false
+	}
+	return kind + "_" + lookup, true
+}
+
+// ExpiresAt turns a lifetime in days into an expiry, where 0 never expires.
+func ExpiresAt(now time.Time, days int) *time.Time {
+	if days <= 0 {
+		return nil
+	}
+	expiresAt := now.AddDate(0, 0, days)
+	return &expiresAt
+}
+
+// IsTenantAdmin reports whether the account is an active admin of the tenant.
+func IsTenantAdmin(ctx context.Context, membershipRepository repository.IMembershipRepository, accountID uuid.UUID, tenantID uuid.UUID) (bool, error) {
+	memberships, err := membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.TenantID == tenantID && membership.Role == string(entity.MembershipRole_Admin) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsTenantMember reports whether the account is an active member of the tenant.
+func IsTenantMember(ctx context.Context, membershipRepository repository.IMembershipRepository, accountID uuid.UUID, tenantID uuid.UUID) (bool, error) {
+	memberships, err := membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.TenantID == tenantID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ValidateScopes is a validator.Custom function for a list of scopes.
+func ValidateScopes(value any, _ any) error {
+	scopes, _ := value.([]string)
+	for _, scope := range scopes {
+		if !ScopePattern.MatchString(scope) {
+			return errInvalidScope
+		}
+	}
+	return nil
+}
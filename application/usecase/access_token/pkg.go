@@ -0,0 +1,20 @@
+package access_token
+
+import (
+	"src/application/usecase/access_token/command/create_personal_access_token"
+	"src/application/usecase/access_token/command/create_tenant_api_key"
+	"src/application/usecase/access_token/command/revoke_access_token"
+	"src/application/usecase/access_token/query/authenticate_access_token"
+	"src/application/usecase/access_token/query/list_personal_access_token"
+	"src/application/usecase/access_token/query/list_tenant_api_key"
+)
+
+func Register() {
+	create_personal_access_token.Register()
+	create_tenant_api_key.Register()
+	revoke_access_token.Register()
+
+	authenticate_access_token.Register()
+	list_personal_access_token.Register()
+	list_tenant_api_key.Register()
+}
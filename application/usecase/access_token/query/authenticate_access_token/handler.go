@@ -0,0 +1,101 @@
+package authenticate_access_token
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"src/application/adapter/crypto"
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_InvalidToken = "access token is invalid, expired or revoked"
+	Err_Failed       = "access token authentication failed"
+
+	// touchInterval bounds how often LastUsedAt is written for a busy token.
+	touchInterval = time.Minute
+)
+
+type Handler struct {
+	cryptoAdapter         crypto.ICryptoAdapter
+	accessTokenRepository repository.IAccessTokenRepository
+	accountRepository     repository.IAccountRepository
+	membershipRepository  repository.IMembershipRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	accessTokenRepository repository.IAccessTokenRepository,
+	accountRepository repository.IAccountRepository,
+	membershipRepository repository.IMembershipRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:         cryptoAdapter,
+		accessTokenRepository: accessTokenRepository,
+		accountRepository:     accountRepository,
+		membershipRepository:  membershipRepository,
+	}
+}
+
+// Handle resolves a bearer access token to the identity it acts as. It is
+// what the authentication guard runs for non-JWT tokens, and the one query
+// with a side effect: it stamps LastUsedAt.
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	prefix, ok := keys.Prefix(query.Token)
+	if !ok {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidToken)
+	}
+	token, err := h.accessTokenRepository.GetByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if token == nil || subtle.ConstantTimeCompare([]byte(h.cryptoAdapter.Hash(query.Token)), []byte(token.TokenHash)) != 1 {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidToken)
+	}
+
+	now := time.Now().UTC()
+	if token.RevokedAt != nil || (token.ExpiresAt != nil && !now.Before(*token.ExpiresAt)) {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidToken)
+	}
+
+	account, err := h.accountRepository.GetByID(ctx, token.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil || account.Status != entity.AccountStatus_Active {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidToken)
+	}
+	// an API key acts for its creator, so it lapses when they leave the tenant
+	if token.TenantID != nil {
+		member, err := keys.IsTenantMember(ctx, h.membershipRepository, token.AccountID, *token.TenantID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if !member {
+			return nil, exception.NewUnauthorized().WithMessage(Err_InvalidToken)
+		}
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= touchInterval {
+		if _, err := h.accessTokenRepository.Touch(ctx, token.ID, now); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+	}
+
+	return &Result{
+		AccessTokenID: token.ID,
+		AccountID:     token.AccountID,
+		TenantID:      token.TenantID,
+		Scopes:        token.Scopes,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package authenticate_access_token
+
+import (
+	"github.com/google/uuid"
+
+	"src/core/validator"
+)
+
+type Query struct {
+	Token string `json:"token"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.Token).Trim().Required().Max(128),
+	).Validate()
+}
+
+type Result struct {
+	AccessTokenID uuid.UUID  `json:"access_token_id"`
+	AccountID     uuid.UUID  `json:"account_id"`
+	TenantID      *uuid.UUID `json:"tenant_id"`
+	Scopes        []string   `json:"scopes"`
+}
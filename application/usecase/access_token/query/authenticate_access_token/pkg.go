@@ -0,0 +1,22 @@
+package authenticate_access_token
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{Token: "cpp_4f9a0c1e2b3d4a5f_0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c"}
+	meta.Describe(&query,
+		meta.Description("Resolve a personal access token or tenant API key to the account, tenant and scopes it acts with"),
+		meta.Example(&query),
+		meta.Field(&query.Token, meta.Description("The bearer token")),
+		meta.Throws[exception.Unauthorized](Err_InvalidToken),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,51 @@
+package list_personal_access_token
+
+import (
+	"context"
+
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "listing access tokens requires an authenticated account"
+	Err_Failed           = "listing access tokens failed"
+)
+
+type Handler struct {
+	accessTokenRepository repository.IAccessTokenRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	accessTokenRepository repository.IAccessTokenRepository,
+) *Handler {
+	return &Handler{
+		accessTokenRepository: accessTokenRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	tokens, err := h.accessTokenRepository.ListPersonal(ctx, *info.AccountID, query.IncludeRevoked)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	items := make([]keys.AccessToken, 0, len(tokens))
+	for i := range tokens {
+		items = append(items, keys.NewAccessToken(&tokens[i]))
+	}
+	return &Result{Items: items}, nil
+}
@@ -0,0 +1,11 @@
+package list_personal_access_token
+
+import "src/application/usecase/access_token/internal/keys"
+
+type Query struct {
+	IncludeRevoked bool `json:"include_revoked"`
+}
+
+type Result struct {
+	Items []keys.AccessToken `json:"items"`
+}
@@ -0,0 +1,22 @@
+package list_personal_access_token
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{}
+	meta.Describe(&query,
+		meta.Description("List the caller's personal access tokens, newest first. Secrets are never returned"),
+		meta.Example(&query),
+		meta.Field(&query.IncludeRevoked, meta.Description("Also return revoked tokens")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+}
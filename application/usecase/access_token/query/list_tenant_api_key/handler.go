@@ -0,0 +1,66 @@
+package list_tenant_api_key
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "listing API keys requires an authenticated account"
+	Err_NotAdmin         = "only an admin of the tenant can list its API keys"
+	Err_Failed           = "listing API keys failed"
+)
+
+type Handler struct {
+	accessTokenRepository repository.IAccessTokenRepository
+	membershipRepository  repository.IMembershipRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	accessTokenRepository repository.IAccessTokenRepository,
+	membershipRepository repository.IMembershipRepository,
+) *Handler {
+	return &Handler{
+		accessTokenRepository: accessTokenRepository,
+		membershipRepository:  membershipRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	tenantID := uuid.MustParse(query.TenantID)
+	admin, err := keys.IsTenantAdmin(ctx, h.membershipRepository, *info.AccountID, tenantID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !admin {
+		return nil, exception.NewForbidden().WithMessage(Err_NotAdmin)
+	}
+
+	tokens, err := h.accessTokenRepository.ListByTenantID(ctx, tenantID, query.IncludeRevoked)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	items := make([]keys.AccessToken, 0, len(tokens))
+	for i := range tokens {
+		items = append(items, keys.NewAccessToken(&tokens[i]))
+	}
+	return &Result{Items: items}, nil
+}
@@ -0,0 +1,23 @@
+package list_tenant_api_key
+
+import (
+	"src/application/usecase/access_token/internal/keys"
+	"src/core/validator"
+)
+
+type Query struct {
+	TenantID       string `json:"tenant_id"`
+	IncludeRevoked bool   `json:"include_revoked"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.TenantID).Trim().Lowercase().Required().GUID(),
+	).Validate()
+}
+
+type Result struct {
+	Items []keys.AccessToken `json:"items"`
+}
@@ -0,0 +1,24 @@
+package list_tenant_api_key
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{TenantID: "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"}
+	meta.Describe(&query,
+		meta.Description("List the API keys of a tenant, newest first. Secrets are never returned"),
+		meta.Example(&query),
+		meta.Field(&query.TenantID, meta.Description("Tenant to list, the caller must be one of its admins")),
+		meta.Field(&query.IncludeRevoked, meta.Description("Also return revoked keys")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_NotAdmin),
+		meta.Throws[exception.Internal](Err_Failed))
+}
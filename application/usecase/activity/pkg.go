@@ -1,7 +1,12 @@
 package activity
 
-import "src/application/usecase/activity/query/search_activity"
+import (
+	"src/application/usecase/activity/query/search_activity"
+	"src/core/cqrs"
+)
 
 func Register() {
+	cqrs.RegisterCommandHook(recordActivity)
+
 	search_activity.Register()
 }
@@ -0,0 +1,126 @@
+package search_activity
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_Failed           = "activity search failed"
+	Err_NotAuthenticated = "activity search requires an authenticated account"
+	Err_TenantNotVisible = "activity of this tenant is not visible to the caller"
+	Err_ActorNotVisible  = "activity of other accounts is only visible within a tenant"
+)
+
+// tenantAuditRoles may see the activity of every member of their tenant.
+var tenantAuditRoles = map[string]bool{
+	string(entity.MembershipRole_Admin):   true,
+	string(entity.MembershipRole_Manager): true,
+}
+
+type Handler struct {
+	activityRepository   repository.IActivityRepository
+	membershipRepository repository.IMembershipRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	activityRepository repository.IActivityRepository,
+	membershipRepository repository.IMembershipRepository,
+) *Handler {
+	return &Handler{
+		activityRepository:   activityRepository,
+		membershipRepository: membershipRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	// without a tenant the caller only sees their own activity; inside a
+	// tenant, admins and managers see everyone's
+	if query.TenantID == "" {
+		if query.ActorAccountID != "" && query.ActorAccountID != info.AccountID.String() {
+			return nil, exception.NewForbidden().WithMessage(Err_ActorNotVisible)
+		}
+	} else {
+		visible, err := h.isTenantVisible(ctx, *info.AccountID, query.TenantID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if !visible {
+			return nil, exception.NewForbidden().WithMessage(Err_TenantNotVisible)
+		}
+	}
+
+	search := builder.NewQuery[entity.ActivityEntity]().
+		Text(query.Text).
+		Where(func(e *entity.ActivityEntity, q *builder.WhereBuilder[entity.ActivityEntity]) {
+			if query.TenantID != "" {
+				q.Equal(&e.TenantID, query.TenantID)
+				if query.ActorAccountID != "" {
+					q.Equal(&e.ActorAccountID, query.ActorAccountID)
+				}
+			} else {
+				q.Equal(&e.ActorAccountID, info.AccountID.String())
+			}
+			if query.Action != "" {
+				q.Equal(&e.Action, query.Action)
+			}
+			if query.TargetType != "" {
+				q.Equal(&e.TargetType, query.TargetType)
+			}
+			if query.TargetID != "" {
+				q.Equal(&e.TargetID, query.TargetID)
+			}
+			if query.Impersonated {
+				q.NotEmpty(&e.ImpersonatorAccountID)
+			}
+			if query.From != nil {
+				q.GreaterEqual(&e.CreatedAt, query.From.UTC())
+			}
+			if query.To != nil {
+				q.LowerEqual(&e.CreatedAt, query.To.UTC())
+			}
+		}).
+		Sort(func(e *entity.ActivityEntity, s *builder.SortBuilder[entity.ActivityEntity]) {
+			s.Desc(&e.CreatedAt)
+		}).
+		Offset(query.Offset).
+		Limit(query.Limit)
+
+	result, err := h.activityRepository.Search(ctx, search)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return result, nil
+}
+
+func (h *Handler) isTenantVisible(ctx context.Context, accountID uuid.UUID, tenantID string) (bool, error) {
+	memberships, err := h.membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.TenantID.String() == tenantID && tenantAuditRoles[membership.Role] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,36 @@
+package search_activity
+
+import (
+	"time"
+
+	"src/core/builder"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	Text           string     `json:"text"`
+	TenantID       string     `json:"tenant_id"`
+	ActorAccountID string     `json:"actor_account_id"`
+	Action         string     `json:"action"`
+	TargetType     string     `json:"target_type"`
+	TargetID       string     `json:"target_id"`
+	Impersonated   bool       `json:"impersonated"`
+	From           *time.Time `json:"from"`
+	To             *time.Time `json:"to"`
+	Offset         int64      `json:"offset"`
+	Limit          int64      `json:"limit"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.TenantID).GUID(),
+		validator.String(&q.ActorAccountID).GUID(),
+		validator.Number(&q.Offset).Integer().Min(0).Default(0),
+		validator.Number(&q.Limit).Integer().Min(1).Max(1000).Default(50),
+	).Validate()
+}
+
+type Result = builder.Result[entity.ActivityEntity]
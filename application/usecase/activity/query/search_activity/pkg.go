@@ -1,8 +1,43 @@
 package search_activity
 
+import (
+	"time"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	query := Query{
+		TenantID: "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50",
+		Action:   "currency.activate_currency",
+		From:     core.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		To:       core.Ptr(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)),
+		Limit:    50,
+	}
+	meta.Describe(&query,
+		meta.Description("Search the activity log, newest first. Without a tenant only the caller's own activity is returned; tenant admins and managers may search the whole tenant"),
+		meta.Example(&query),
+		meta.Field(&query.Text, meta.Description("Free text matched against the whole activity record")),
+		meta.Field(&query.TenantID, meta.Description("Tenant whose activity is searched")),
+		meta.Field(&query.ActorAccountID, meta.Description("Only activity performed by this account")),
+		meta.Field(&query.Action, meta.Description("Only activity of this action, e.g. \"currency.activate_currency\"")),
+		meta.Field(&query.TargetType, meta.Description("Only activity on this kind of entity")),
+		meta.Field(&query.TargetID, meta.Description("Only activity on this entity")),
+		meta.Field(&query.Impersonated, meta.Description("Only activity performed by a platform admin impersonating the actor")),
+		meta.Field(&query.From, meta.Description("Only activity at or after this instant")),
+		meta.Field(&query.To, meta.Description("Only activity at or before this instant")),
+		meta.Field(&query.Offset, meta.Description("Number of items to skip")),
+		meta.Field(&query.Limit, meta.Description("Maximum number of items to return")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_TenantNotVisible),
+		meta.Throws[exception.Forbidden](Err_ActorNotVisible),
+		meta.Throws[exception.Internal](Err_Failed))
+}
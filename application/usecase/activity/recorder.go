@@ -0,0 +1,178 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/logger"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys are json keys (or key fragments) never written to the log.
+var sensitiveKeys = []string{"password", "secret", "token", "private_key", "recovery_code", "otp"}
+
+// IActivityTarget is implemented by commands or results that know which
+// entity they acted on. Without it the target is the use case domain.
+type IActivityTarget interface {
+	ActivityTarget() (targetType string, targetID string)
+}
+
+// IActivityChange is implemented by results that can describe the state
+// before and after the command. Without it the result is logged as "after".
+type IActivityChange interface {
+	ActivityChange() (before any, after any)
+}
+
+// recordActivity is the command hook that appends every successful command
+// to the activity log.
+func recordActivity(ctx context.Context, command cqrs.Command, result any, err error) {
+	if err != nil {
+		return
+	}
+
+	activity, err := newActivity(ctx, command, result)
+	if err == nil {
+		err = di.Resolve[repository.IActivityRepository]().Insert(ctx, activity)
+	}
+	if err != nil {
+		di.Resolve[logger.ILoggerAdapter]().Error("activity not recorded", map[string]any{
+			"action": cqrs.UseCaseName(command),
+			"err":    err.Error(),
+		})
+	}
+}
+
+func newActivity(ctx context.Context, command cqrs.Command, result any) (*entity.ActivityEntity, error) {
+	info := common.GetRequestInfo(ctx)
+	action := cqrs.UseCaseName(command)
+
+	targetType, _, _ := strings.Cut(action, ".")
+	var targetID *string
+	for _, source := range []any{result, command} {
+		if target, ok := source.(IActivityTarget); ok {
+			name, id := target.ActivityTarget()
+			targetType = name
+			if id != "" {
+				targetID = &id
+			}
+			break
+		}
+	}
+
+	var before, after any = nil, result
+	if change, ok := result.(IActivityChange); ok {
+		before, after = change.ActivityChange()
+	}
+
+	beforeJSON, afterJSON, err := diff(before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ActivityEntity{
+		ID:             uuid.New(),
+		CreatedAt:      time.Now().UTC(),
+		Action:         action,
+		TargetType:     targetType,
+		TargetID:       targetID,
+		Before:         beforeJSON,
+		After:          afterJSON,
+		IP:             info.IP,
+		UserAgent:      info.UserAgent,
+		ActorAccountID: info.AccountID,
+		TenantID:       info.TenantID,
+
+		ImpersonatorAccountID: info.ImpersonatorAccountID,
+	}, nil
+}
+
+// diff keeps only the top-level fields whose value changed between before
+// and after, with sensitive values redacted.
+func diff(before any, after any) (json.RawMessage, json.RawMessage, error) {
+	beforeValue, err := toJSONValue(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterValue, err := toJSONValue(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	beforeMap, beforeIsMap := beforeValue.(map[string]any)
+	afterMap, afterIsMap := afterValue.(map[string]any)
+	if beforeIsMap && afterIsMap {
+		for key, value := range beforeMap {
+			if other, ok := afterMap[key]; ok && reflect.DeepEqual(value, other) {
+				delete(beforeMap, key)
+				delete(afterMap, key)
+			}
+		}
+	}
+
+	beforeJSON, err := json.Marshal(redact(beforeValue))
+	if err != nil {
+		return nil, nil, err
+	}
+	afterJSON, err := json.Marshal(redact(afterValue))
+	if err != nil {
+		return nil, nil, err
+	}
+	return beforeJSON, afterJSON, nil
+}
+
+func toJSONValue(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func redact(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			if isSensitiveKey(key) {
+				typed[key] = redactedValue
+			} else {
+				typed[key] = redact(item)
+			}
+		}
+		return typed
+	case []any:
+		for index, item := range typed {
+			typed[index] = redact(item)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, fragment := range sensitiveKeys {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package delete_certificate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated    = "deleting a certificate requires an authenticated account"
+	Err_CertificateNotFound = "certificate not found"
+	Err_Failed              = "certificate deletion failed"
+)
+
+type Handler struct {
+	certificateRepository repository.IAccountCertificateRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	certificateRepository repository.IAccountCertificateRepository,
+) *Handler {
+	return &Handler{
+		certificateRepository: certificateRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	deleted, err := h.certificateRepository.Delete(ctx, *info.AccountID, uuid.MustParse(command.CertificateID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if deleted == 0 {
+		return nil, exception.NewNotFound().WithMessage(Err_CertificateNotFound)
+	}
+
+	return &Result{}, nil
+}
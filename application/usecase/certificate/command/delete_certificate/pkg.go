@@ -0,0 +1,23 @@
+package delete_certificate
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{CertificateID: "7a1e9c40-2b3d-4f5e-8a6b-9c0d1e2f3a4b"}
+	meta.Describe(&command,
+		meta.Description("Permanently delete one of the caller's certificates and its encrypted file"),
+		meta.Example(&command),
+		meta.Field(&command.CertificateID, meta.Description("Certificate to delete")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_CertificateNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,62 @@
+package revoke_certificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated    = "revoking a certificate requires an authenticated account"
+	Err_CertificateNotFound = "certificate not found"
+	Err_CertificateRevoked  = "certificate is already revoked"
+	Err_Failed              = "certificate revocation failed"
+)
+
+type Handler struct {
+	certificateRepository repository.IAccountCertificateRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	certificateRepository repository.IAccountCertificateRepository,
+) *Handler {
+	return &Handler{
+		certificateRepository: certificateRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	certificateID := uuid.MustParse(command.CertificateID)
+	certificate, err := h.certificateRepository.GetByID(ctx, *info.AccountID, certificateID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if certificate == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_CertificateNotFound)
+	}
+	if certificate.RevokedAt != nil {
+		return nil, exception.NewConflict().WithMessage(Err_CertificateRevoked)
+	}
+
+	if _, err := h.certificateRepository.Revoke(ctx, *info.AccountID, certificateID, time.Now()); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{}, nil
+}
@@ -0,0 +1,21 @@
+package revoke_certificate
+
+import "src/core/validator"
+
+type Command struct {
+	CertificateID string `json:"certificate_id"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.CertificateID).Required().GUID(),
+	).Validate()
+}
+
+func (c *Command) ActivityTarget() (string, string) {
+	return "account_certificate", c.CertificateID
+}
+
+type Result struct{}
@@ -0,0 +1,24 @@
+package revoke_certificate
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{CertificateID: "7a1e9c40-2b3d-4f5e-8a6b-9c0d1e2f3a4b"}
+	meta.Describe(&command,
+		meta.Description("Revoke one of the caller's certificates so it can no longer sign; the record is kept"),
+		meta.Example(&command),
+		meta.Field(&command.CertificateID, meta.Description("Certificate to revoke")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_CertificateNotFound),
+		meta.Throws[exception.Conflict](Err_CertificateRevoked),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,104 @@
+package upload_certificate
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/certificate"
+	"src/application/adapter/crypto"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+// MaxContentSize bounds the uploaded container; real .pfx files are a few KB.
+const MaxContentSize = 64 * 1024
+
+const (
+	Err_NotAuthenticated           = "uploading a certificate requires an authenticated account"
+	Err_IncorrectPassword          = "certificate password is incorrect"
+	Err_InvalidCertificate         = "file is not a valid PKCS#12 certificate"
+	Err_CertificateExpired         = "certificate is already expired"
+	Err_CertificateAlreadyUploaded = "certificate was already uploaded"
+	Err_Failed                     = "certificate upload failed"
+)
+
+type Handler struct {
+	certificateAdapter    certificate.ICertificateAdapter
+	cryptoAdapter         crypto.ICryptoAdapter
+	certificateRepository repository.IAccountCertificateRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	certificateAdapter certificate.ICertificateAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	certificateRepository repository.IAccountCertificateRepository,
+) *Handler {
+	return &Handler{
+		certificateAdapter:    certificateAdapter,
+		cryptoAdapter:         cryptoAdapter,
+		certificateRepository: certificateRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	parsed, err := h.certificateAdapter.ParsePKCS12(command.Content, command.Password)
+	if errors.Is(err, certificate.ErrCertificate_IncorrectPassword) {
+		return nil, exception.NewUnprocessableEntity().WithMessage(Err_IncorrectPassword)
+	}
+	if err != nil {
+		return nil, exception.NewUnprocessableEntity().WithCause(err).WithMessage(Err_InvalidCertificate)
+	}
+
+	now := time.Now().UTC()
+	if !parsed.NotAfter.After(now) {
+		return nil, exception.NewUnprocessableEntity().WithMessage(Err_CertificateExpired)
+	}
+
+	exists, err := h.certificateRepository.ExistsByFingerprint(ctx, *info.AccountID, parsed.Fingerprint)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if exists {
+		return nil, exception.NewConflict().WithMessage(Err_CertificateAlreadyUploaded)
+	}
+
+	accountCertificate := &entity.AccountCertificateEntity{
+		ID:                uuid.New(),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		ExpiresAt:         &parsed.NotAfter,
+		Fingerprint:       parsed.Fingerprint,
+		Subject:           parsed.Subject,
+		Issuer:            parsed.Issuer,
+		SerialNumber:      parsed.SerialNumber,
+		EncryptedPayload:  h.cryptoAdapter.Encrypt(base64.StdEncoding.EncodeToString(command.Content)),
+		EncryptedPassword: h.cryptoAdapter.Encrypt(command.Password),
+		AccountID:         *info.AccountID,
+	}
+	if err := h.certificateRepository.Insert(ctx, accountCertificate); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	warningDays := h.certificateAdapter.Config().ExpiryWarningDays
+	return &Result{
+		Certificate: accountCertificate.WithoutSecrets(),
+		ExpiresSoon: parsed.NotAfter.Before(now.AddDate(0, 0, warningDays)),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package upload_certificate
+
+import (
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Command struct {
+	Content  []byte `json:"content"`
+	Password string `json:"password"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.Binary(&c.Content).Required().Max(MaxContentSize),
+		validator.String(&c.Password).Required(),
+	).Validate()
+}
+
+type Result struct {
+	Certificate entity.AccountCertificateEntity `json:"certificate"`
+	ExpiresSoon bool                            `json:"expires_soon"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "account_certificate", r.Certificate.ID.String()
+}
@@ -0,0 +1,52 @@
+package upload_certificate
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Content: []byte("MIIK..."), Password: "certificate-password"}
+	meta.Describe(&command,
+		meta.Description("Store a PKCS#12 (.pfx) certificate of the caller; the file and its password are kept encrypted"),
+		meta.Example(&command),
+		meta.Field(&command.Content, meta.Description("PKCS#12 file, base64 encoded")),
+		meta.Field(&command.Password, meta.Description("Password of the PKCS#12 file")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.UnprocessableEntity](Err_IncorrectPassword),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidCertificate),
+		meta.Throws[exception.UnprocessableEntity](Err_CertificateExpired),
+		meta.Throws[exception.Conflict](Err_CertificateAlreadyUploaded),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Certificate: entity.AccountCertificateEntity{
+			ID:           uuid.MustParse("7a1e9c40-2b3d-4f5e-8a6b-9c0d1e2f3a4b"),
+			CreatedAt:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpiresAt:    core.Ptr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Fingerprint:  "3f6c1b0e9d8a7f6e5d4c3b2a1908f7e6d5c4b3a29180f7e6d5c4b3a291807f6e",
+			Subject:      "CN=ACME LTDA:12345678000199,O=ICP-Brasil",
+			Issuer:       "CN=AC Example v5,O=ICP-Brasil",
+			SerialNumber: "5A3C19F0E2B7",
+			AccountID:    uuid.MustParse("0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"),
+		},
+	}
+	meta.Describe(&result,
+		meta.Description("Stored certificate"),
+		meta.Example(&result),
+		meta.Field(&result.Certificate, meta.Description("Certificate metadata, without the encrypted file and password")),
+		meta.Field(&result.ExpiresSoon, meta.Description("Whether the certificate expires within the warning window")))
+}
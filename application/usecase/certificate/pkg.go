@@ -0,0 +1,18 @@
+package certificate
+
+import (
+	"src/application/usecase/certificate/command/delete_certificate"
+	"src/application/usecase/certificate/command/revoke_certificate"
+	"src/application/usecase/certificate/command/upload_certificate"
+	"src/application/usecase/certificate/query/list_certificate"
+	"src/application/usecase/certificate/query/list_expiring_certificate"
+)
+
+func Register() {
+	delete_certificate.Register()
+	revoke_certificate.Register()
+	upload_certificate.Register()
+
+	list_certificate.Register()
+	list_expiring_certificate.Register()
+}
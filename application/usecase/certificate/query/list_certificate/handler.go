@@ -0,0 +1,46 @@
+package list_certificate
+
+import (
+	"context"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "listing certificates requires an authenticated account"
+	Err_Failed           = "certificate listing failed"
+)
+
+type Handler struct {
+	certificateRepository repository.IAccountCertificateRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	certificateRepository repository.IAccountCertificateRepository,
+) *Handler {
+	return &Handler{
+		certificateRepository: certificateRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	result, err := h.certificateRepository.List(ctx, *info.AccountID, query.IncludeRevoked, query.Offset, query.Limit)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,24 @@
+package list_certificate
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{Limit: 50}
+	meta.Describe(&query,
+		meta.Description("List the caller's certificates, soonest to expire first"),
+		meta.Example(&query),
+		meta.Field(&query.IncludeRevoked, meta.Description("Also return revoked certificates")),
+		meta.Field(&query.Offset, meta.Description("Number of items to skip")),
+		meta.Field(&query.Limit, meta.Description("Maximum number of items to return")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+}
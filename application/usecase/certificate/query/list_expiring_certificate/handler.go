@@ -0,0 +1,62 @@
+package list_expiring_certificate
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/certificate"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "listing certificates requires an authenticated account"
+	Err_Failed           = "expiring certificate listing failed"
+)
+
+type Handler struct {
+	certificateAdapter    certificate.ICertificateAdapter
+	certificateRepository repository.IAccountCertificateRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	certificateAdapter certificate.ICertificateAdapter,
+	certificateRepository repository.IAccountCertificateRepository,
+) *Handler {
+	return &Handler{
+		certificateAdapter:    certificateAdapter,
+		certificateRepository: certificateRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	withinDays := query.WithinDays
+	if withinDays == 0 {
+		withinDays = h.certificateAdapter.Config().ExpiryWarningDays
+	}
+
+	now := time.Now()
+	result, err := h.certificateRepository.ListExpiring(ctx,
+		*info.AccountID,
+		now,
+		now.AddDate(0, 0, withinDays),
+		query.Offset,
+		query.Limit)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return result, nil
+}
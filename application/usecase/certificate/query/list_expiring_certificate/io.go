@@ -0,0 +1,25 @@
+package list_expiring_certificate
+
+import (
+	"src/core/builder"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	WithinDays int   `json:"within_days"`
+	Offset     int64 `json:"offset"`
+	Limit      int64 `json:"limit"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.Number(&q.WithinDays).Integer().Min(0).Max(365),
+		validator.Number(&q.Offset).Integer().Min(0).Default(0),
+		validator.Number(&q.Limit).Integer().Min(1).Max(1000).Default(50),
+	).Validate()
+}
+
+type Result = builder.Result[entity.AccountCertificateEntity]
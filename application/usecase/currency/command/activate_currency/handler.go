@@ -0,0 +1,50 @@
+package activate_currency
+
+import (
+	"context"
+
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_CurrencyNotFound = "currency not found"
+	Err_Failed           = "currency activation failed"
+)
+
+type Handler struct {
+	currencyRepository repository.ICurrencyRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	currencyRepository repository.ICurrencyRepository,
+) *Handler {
+	return &Handler{
+		currencyRepository: currencyRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	currency, err := h.currencyRepository.GetByCode(ctx, command.CurrencyCode)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if currency == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_CurrencyNotFound)
+	}
+
+	if !currency.IsActive {
+		currency.IsActive = true
+		if err := h.currencyRepository.Update(ctx, currency); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+	}
+
+	return &Result{Currency: *currency}, nil
+}
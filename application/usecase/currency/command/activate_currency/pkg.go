@@ -0,0 +1,40 @@
+package activate_currency
+
+import (
+	"time"
+
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{CurrencyCode: "BRL"}
+	meta.Describe(&command,
+		meta.Description("Activate a currency so it can be used by tenants"),
+		meta.Example(&command),
+		meta.Field(&command.CurrencyCode, meta.Description("ISO 4217 code of the currency")),
+		meta.Throws[exception.NotFound](Err_CurrencyNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Currency: entity.CurrencyEntity{
+			Code:      "BRL",
+			Name:      "Brazilian Real",
+			MinorUnit: 2,
+			Symbol:    "R$",
+			IsActive:  true,
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	meta.Describe(&result,
+		meta.Description("Activated currency"),
+		meta.Example(&result),
+		meta.Field(&result.Currency, meta.Description("Currency after the change")))
+}
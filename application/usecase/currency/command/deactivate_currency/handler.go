@@ -0,0 +1,68 @@
+package deactivate_currency
+
+import (
+	"context"
+
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_CurrencyNotFound = "currency not found"
+	Err_CurrencyInUse    = "currency is the default currency of one or more tenants"
+	Err_Failed           = "currency deactivation failed"
+)
+
+type Handler struct {
+	currencyRepository            repository.ICurrencyRepository
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	currencyRepository repository.ICurrencyRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+) *Handler {
+	return &Handler{
+		currencyRepository:            currencyRepository,
+		tenantConfigurationRepository: tenantConfigurationRepository,
+	}
+}
+
+// Handle deactivates a currency no tenant uses as its default. The currency
+// stays locked until the transaction ends, and a tenant choosing it as its
+// default share-locks it, so neither can slip between the check and the
+// update of the other.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	currency, err := h.currencyRepository.LockByCode(ctx, command.CurrencyCode, true)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if currency == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_CurrencyNotFound)
+	}
+
+	if !currency.IsActive {
+		return &Result{Currency: *currency}, nil
+	}
+
+	references, err := h.tenantConfigurationRepository.CountByDefaultCurrencyCode(ctx, currency.Code)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if references > 0 {
+		return nil, exception.NewConflict().WithMessage(Err_CurrencyInUse)
+	}
+
+	currency.IsActive = false
+	if err := h.currencyRepository.Update(ctx, currency); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Currency: *currency}, nil
+}
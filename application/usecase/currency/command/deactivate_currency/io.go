@@ -0,0 +1,31 @@
+package deactivate_currency
+
+import (
+	"src/core/cqrs"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Command struct {
+	CurrencyCode string `json:"currency_code"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.CurrencyCode).Required().Length(3),
+	).Validate()
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	Currency entity.CurrencyEntity `json:"currency"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "currency", r.Currency.Code
+}
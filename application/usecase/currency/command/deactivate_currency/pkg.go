@@ -0,0 +1,41 @@
+package deactivate_currency
+
+import (
+	"time"
+
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{CurrencyCode: "BRL"}
+	meta.Describe(&command,
+		meta.Description("Deactivate a currency that no tenant uses as default"),
+		meta.Example(&command),
+		meta.Field(&command.CurrencyCode, meta.Description("ISO 4217 code of the currency")),
+		meta.Throws[exception.NotFound](Err_CurrencyNotFound),
+		meta.Throws[exception.Conflict](Err_CurrencyInUse),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Currency: entity.CurrencyEntity{
+			Code:      "BRL",
+			Name:      "Brazilian Real",
+			MinorUnit: 2,
+			Symbol:    "R$",
+			IsActive:  false,
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	meta.Describe(&result,
+		meta.Description("Deactivated currency"),
+		meta.Example(&result),
+		meta.Field(&result.Currency, meta.Description("Currency after the change")))
+}
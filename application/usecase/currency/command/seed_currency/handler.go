@@ -0,0 +1,110 @@
+package seed_currency
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_InvalidDataset = "embedded ISO 4217 dataset is invalid"
+	Err_Failed         = "currency seed failed"
+)
+
+//go:embed iso4217.json
+var iso4217Dataset []byte
+
+type iso4217Entry struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	MinorUnit int    `json:"minor_unit"`
+	Symbol    string `json:"symbol"`
+}
+
+type Handler struct {
+	currencyRepository repository.ICurrencyRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	currencyRepository repository.ICurrencyRepository,
+) *Handler {
+	return &Handler{
+		currencyRepository: currencyRepository,
+	}
+}
+
+// Handle inserts missing ISO 4217 currencies, active only when listed in
+// ActiveCodes, and refreshes name, minor unit and symbol of existing ones
+// without touching IsActive, so it can run on every startup.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	var entries []iso4217Entry
+	if err := json.Unmarshal(iso4217Dataset, &entries); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_InvalidDataset)
+	}
+
+	activeCodes := make([]string, 0, len(command.ActiveCodes))
+	for _, code := range command.ActiveCodes {
+		activeCodes = append(activeCodes, strings.ToUpper(strings.TrimSpace(code)))
+	}
+
+	existing, err := h.currencyRepository.List(ctx, false)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	existingByCode := make(map[string]entity.CurrencyEntity, len(existing))
+	for _, currency := range existing {
+		existingByCode[currency.Code] = currency
+	}
+
+	var result Result
+	var inserts []entity.CurrencyEntity
+	now := time.Now().UTC()
+
+	for _, entry := range entries {
+		current, found := existingByCode[entry.Code]
+		if !found {
+			inserts = append(inserts, entity.CurrencyEntity{
+				Code:      entry.Code,
+				Name:      entry.Name,
+				MinorUnit: entry.MinorUnit,
+				Symbol:    entry.Symbol,
+				IsActive:  slices.Contains(activeCodes, entry.Code),
+				CreatedAt: now,
+			})
+			continue
+		}
+
+		if current.Name == entry.Name && current.MinorUnit == entry.MinorUnit && current.Symbol == entry.Symbol {
+			result.Skipped++
+			continue
+		}
+
+		current.Name = entry.Name
+		current.MinorUnit = entry.MinorUnit
+		current.Symbol = entry.Symbol
+		if err := h.currencyRepository.Update(ctx, &current); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		result.Updated++
+	}
+
+	if err := h.currencyRepository.Insert(ctx, inserts); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	result.Inserted = len(inserts)
+	return &result, nil
+}
@@ -0,0 +1,19 @@
+package seed_currency
+
+import "src/core/cqrs"
+
+type Command struct {
+	// ActiveCodes are the currencies inserted as active; every other one
+	// starts inactive until activate_currency is called for it
+	ActiveCodes []string `json:"active_codes"`
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	Skipped  int `json:"skipped"`
+}
@@ -0,0 +1,30 @@
+package seed_currency
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{ActiveCodes: []string{"BRL", "EUR", "USD"}}
+	meta.Describe(&command,
+		meta.Description("Seed the currency catalog from the embedded ISO 4217 dataset"),
+		meta.Example(&command),
+		meta.Field(&command.ActiveCodes, meta.Description("Currencies inserted as active, every other one starts inactive")),
+		meta.Throws[exception.Internal](Err_InvalidDataset),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Inserted: 157, Updated: 0, Skipped: 0}
+	meta.Describe(&result,
+		meta.Description("Currency seed result"),
+		meta.Example(&result),
+		meta.Field(&result.Inserted, meta.Description("Currencies added to the catalog")),
+		meta.Field(&result.Updated, meta.Description("Currencies whose name, minor unit or symbol changed")),
+		meta.Field(&result.Skipped, meta.Description("Currencies already up to date")))
+}
@@ -0,0 +1,84 @@
+package sync_exchange_rate
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/exchange_rate"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_FetchFailed = "exchange rate provider fetch failed"
+	Err_Failed      = "exchange rate sync failed"
+)
+
+type Handler struct {
+	exchangeRateProvider   exchange_rate.IExchangeRateProvider
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	exchangeRateProvider exchange_rate.IExchangeRateProvider,
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository,
+) *Handler {
+	return &Handler{
+		exchangeRateProvider:   exchangeRateProvider,
+		exchangeRateRepository: exchangeRateRepository,
+	}
+}
+
+// Handle imports provider rates in one transaction. Rates already stored for
+// the same pair and instant are skipped, so running it repeatedly is safe.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	provided, err := h.exchangeRateProvider.Fetch(ctx)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_FetchFailed)
+	}
+
+	result := Result{Provider: h.exchangeRateProvider.Name()}
+	now := time.Now().UTC()
+	rates := make([]entity.CurrencyExchangeRateEntity, 0, len(provided))
+
+	for _, item := range provided {
+		exists, err := h.exchangeRateRepository.Exists(ctx,
+			item.BaseCurrencyCode,
+			item.QuoteCurrencyCode,
+			entity.CurrencyExchangeRateSource_Provider,
+			item.EffectiveAt)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if exists {
+			result.Skipped++
+			continue
+		}
+
+		rates = append(rates, entity.CurrencyExchangeRateEntity{
+			ID:                uuid.New(),
+			CreatedAt:         now,
+			EffectiveAt:       item.EffectiveAt,
+			Rate:              item.Rate,
+			Source:            entity.CurrencyExchangeRateSource_Provider,
+			BaseCurrencyCode:  item.BaseCurrencyCode,
+			QuoteCurrencyCode: item.QuoteCurrencyCode,
+		})
+	}
+
+	if err := h.exchangeRateRepository.Insert(ctx, rates); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	result.Imported = len(rates)
+	return &result, nil
+}
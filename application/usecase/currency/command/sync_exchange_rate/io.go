@@ -0,0 +1,16 @@
+package sync_exchange_rate
+
+import "src/core/cqrs"
+
+type Command struct {
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	Provider string `json:"provider"`
+	Imported int    `json:"imported"`
+	Skipped  int    `json:"skipped"`
+}
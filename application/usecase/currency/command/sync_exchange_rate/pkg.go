@@ -0,0 +1,28 @@
+package sync_exchange_rate
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{}
+	meta.Describe(&command,
+		meta.Description("Import the latest exchange rates from the configured provider"),
+		meta.Throws[exception.Internal](Err_FetchFailed),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Provider: "ecb", Imported: 30, Skipped: 0}
+	meta.Describe(&result,
+		meta.Description("Exchange rate sync result"),
+		meta.Example(&result),
+		meta.Field(&result.Provider, meta.Description("Name of the provider the rates came from")),
+		meta.Field(&result.Imported, meta.Description("Number of new rates stored")),
+		meta.Field(&result.Skipped, meta.Description("Number of rates already stored and skipped")))
+}
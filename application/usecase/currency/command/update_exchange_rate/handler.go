@@ -0,0 +1,88 @@
+package update_exchange_rate
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_InvalidRate      = "rate must be a positive decimal number"
+	Err_SameCurrency     = "base and quote currencies must differ"
+	Err_CurrencyNotFound = "currency not found"
+	Err_Failed           = "exchange rate update failed"
+)
+
+type Handler struct {
+	currencyRepository     repository.ICurrencyRepository
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	currencyRepository repository.ICurrencyRepository,
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository,
+) *Handler {
+	return &Handler{
+		currencyRepository:     currencyRepository,
+		exchangeRateRepository: exchangeRateRepository,
+	}
+}
+
+// Handle records a MANUAL rate. Manual rates never replace provider history:
+// they are stored alongside it and win when both share the same instant.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	rate, err := entity.ParseRate(command.Rate)
+	if err != nil || rate.Cmp(entity.MustParseRate("0")) <= 0 {
+		return nil, exception.NewValidation().WithMessage(Err_InvalidRate)
+	}
+
+	base := strings.ToUpper(command.BaseCurrencyCode)
+	quote := strings.ToUpper(command.QuoteCurrencyCode)
+	if base == quote {
+		return nil, exception.NewValidation().WithMessage(Err_SameCurrency)
+	}
+
+	for _, code := range []string{base, quote} {
+		currency, err := h.currencyRepository.GetByCode(ctx, code)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if currency == nil {
+			return nil, exception.NewNotFound().WithMessage(Err_CurrencyNotFound)
+		}
+	}
+
+	now := time.Now().UTC()
+	effectiveAt := now
+	if command.EffectiveAt != nil {
+		effectiveAt = command.EffectiveAt.UTC()
+	}
+
+	exchangeRate := entity.CurrencyExchangeRateEntity{
+		ID:                uuid.New(),
+		CreatedAt:         now,
+		EffectiveAt:       effectiveAt,
+		Rate:              rate,
+		Source:            entity.CurrencyExchangeRateSource_Manual,
+		BaseCurrencyCode:  base,
+		QuoteCurrencyCode: quote,
+	}
+
+	if err := h.exchangeRateRepository.Insert(ctx, []entity.CurrencyExchangeRateEntity{exchangeRate}); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{ExchangeRate: exchangeRate}, nil
+}
@@ -0,0 +1,34 @@
+package update_exchange_rate
+
+import (
+	"time"
+
+	"src/core/cqrs"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Command struct {
+	BaseCurrencyCode  string     `json:"base_currency_code"`
+	QuoteCurrencyCode string     `json:"quote_currency_code"`
+	Rate              string     `json:"rate"`
+	EffectiveAt       *time.Time `json:"effective_at"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.BaseCurrencyCode).Required().Length(3),
+		validator.String(&c.QuoteCurrencyCode).Required().Length(3),
+		validator.String(&c.Rate).Required(),
+	).Validate()
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	ExchangeRate entity.CurrencyExchangeRateEntity `json:"exchange_rate"`
+}
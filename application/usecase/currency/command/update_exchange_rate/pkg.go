@@ -1,8 +1,54 @@
 package update_exchange_rate
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	command := Command{
+		BaseCurrencyCode:  "USD",
+		QuoteCurrencyCode: "BRL",
+		Rate:              "5.4321",
+		EffectiveAt:       core.Ptr(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}
+	meta.Describe(&command,
+		meta.Description("Record a manual exchange rate override"),
+		meta.Example(&command),
+		meta.Field(&command.BaseCurrencyCode, meta.Description("ISO 4217 code of the base currency")),
+		meta.Field(&command.QuoteCurrencyCode, meta.Description("ISO 4217 code of the quote currency")),
+		meta.Field(&command.Rate, meta.Description("Decimal rate, units of quote currency per base unit")),
+		meta.Field(&command.EffectiveAt, meta.Description("Instant the override takes effect, defaults to now")),
+		meta.Throws[exception.Validation](Err_InvalidRate),
+		meta.Throws[exception.Validation](Err_SameCurrency),
+		meta.Throws[exception.NotFound](Err_CurrencyNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		ExchangeRate: entity.CurrencyExchangeRateEntity{
+			ID:                uuid.MustParse("6f1c0f3e-7a35-4a3e-9c55-1a3f1b8d2e10"),
+			CreatedAt:         time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			EffectiveAt:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			Rate:              entity.MustParseRate("5.4321"),
+			Source:            entity.CurrencyExchangeRateSource_Manual,
+			BaseCurrencyCode:  "USD",
+			QuoteCurrencyCode: "BRL",
+		},
+	}
+	meta.Describe(&result,
+		meta.Description("Stored exchange rate"),
+		meta.Example(&result),
+		meta.Field(&result.ExchangeRate, meta.Description("The manual exchange rate that was recorded")))
+}
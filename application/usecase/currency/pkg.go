@@ -1,14 +1,26 @@
 package currency
 
 import (
+	"src/application/usecase/currency/command/activate_currency"
+	"src/application/usecase/currency/command/deactivate_currency"
+	"src/application/usecase/currency/command/seed_currency"
+	"src/application/usecase/currency/command/sync_exchange_rate"
 	"src/application/usecase/currency/command/update_exchange_rate"
+	"src/application/usecase/currency/query/convert_amount"
 	"src/application/usecase/currency/query/get_exchange_rate"
 	"src/application/usecase/currency/query/list_currency"
+	"src/application/usecase/currency/query/list_exchange_rate_history"
 )
 
 func Register() {
+	activate_currency.Register()
+	deactivate_currency.Register()
+	seed_currency.Register()
+	sync_exchange_rate.Register()
 	update_exchange_rate.Register()
 
+	convert_amount.Register()
 	get_exchange_rate.Register()
 	list_currency.Register()
+	list_exchange_rate_history.Register()
 }
@@ -0,0 +1,82 @@
+package convert_amount
+
+import (
+	"context"
+
+	"src/application/usecase/currency/query/get_exchange_rate"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_CurrencyNotFound = "currency not found"
+	Err_InvalidAmount    = "amount must be a decimal number"
+	Err_Failed           = "amount conversion failed"
+)
+
+type Handler struct {
+	currencyRepository repository.ICurrencyRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	currencyRepository repository.ICurrencyRepository,
+) *Handler {
+	return &Handler{
+		currencyRepository: currencyRepository,
+	}
+}
+
+func (h *Handler) getCurrency(ctx context.Context, code string) (*entity.CurrencyEntity, error) {
+	currency, err := h.currencyRepository.GetByCode(ctx, code)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if currency == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_CurrencyNotFound)
+	}
+	return currency, nil
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	from, err := h.getCurrency(ctx, query.BaseCurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+	to, err := h.getCurrency(ctx, query.QuoteCurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := entity.ParseMoney(query.Amount, from)
+	if err != nil {
+		return nil, exception.NewValidation().WithCause(err).WithMessage(Err_InvalidAmount)
+	}
+
+	exchangeRate, err := cqrs.ExecuteQuery[get_exchange_rate.Result](ctx, &get_exchange_rate.Query{
+		BaseCurrencyCode:  from.Code,
+		QuoteCurrencyCode: to.Code,
+		At:                query.At,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err := source.Convert(exchangeRate.Rate, from, to)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{
+		Source:      source,
+		Converted:   converted,
+		Rate:        exchangeRate.Rate,
+		EffectiveAt: exchangeRate.EffectiveAt,
+	}, nil
+}
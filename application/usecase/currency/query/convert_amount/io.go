@@ -0,0 +1,32 @@
+package convert_amount
+
+import (
+	"time"
+
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	Amount            string     `json:"amount"`
+	BaseCurrencyCode  string     `json:"base_currency_code"`
+	QuoteCurrencyCode string     `json:"quote_currency_code"`
+	At                *time.Time `json:"at"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.Amount).Required(),
+		validator.String(&q.BaseCurrencyCode).Required().Length(3),
+		validator.String(&q.QuoteCurrencyCode).Required().Length(3),
+	).Validate()
+}
+
+type Result struct {
+	Source      entity.Money `json:"source"`
+	Converted   entity.Money `json:"converted"`
+	Rate        entity.Rate  `json:"rate"`
+	EffectiveAt time.Time    `json:"effective_at"`
+}
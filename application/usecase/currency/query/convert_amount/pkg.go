@@ -0,0 +1,49 @@
+package convert_amount
+
+import (
+	"time"
+
+	"src/application/usecase/currency/query/get_exchange_rate"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{
+		Amount:            "100.00",
+		BaseCurrencyCode:  "USD",
+		QuoteCurrencyCode: "BRL",
+	}
+	meta.Describe(&query,
+		meta.Description("Convert an amount between currencies using the rate effective at a point in time"),
+		meta.Example(&query),
+		meta.Field(&query.Amount, meta.Description("Decimal amount in the base currency")),
+		meta.Field(&query.BaseCurrencyCode, meta.Description("ISO 4217 code of the amount currency")),
+		meta.Field(&query.QuoteCurrencyCode, meta.Description("ISO 4217 code of the target currency")),
+		meta.Field(&query.At, meta.Description("Instant the rate must be effective at, defaults to now")),
+		meta.Throws[exception.Validation](Err_InvalidAmount),
+		meta.Throws[exception.NotFound](Err_CurrencyNotFound),
+		meta.Throws[exception.NotFound](get_exchange_rate.Err_RateNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Source:      entity.NewMoney(10000, "USD"),
+		Converted:   entity.NewMoney(61834, "BRL"),
+		Rate:        entity.MustParseRate("6.1834"),
+		EffectiveAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	meta.Describe(&result,
+		meta.Description("Amount conversion result"),
+		meta.Example(&result),
+		meta.Field(&result.Source, meta.Description("Amount in minor units of the base currency")),
+		meta.Field(&result.Converted, meta.Description("Converted amount in minor units of the quote currency, rounded half to even")),
+		meta.Field(&result.Rate, meta.Description("Rate applied")),
+		meta.Field(&result.EffectiveAt, meta.Description("Instant the applied rate became effective")))
+}
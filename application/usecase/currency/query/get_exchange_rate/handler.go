@@ -0,0 +1,138 @@
+package get_exchange_rate
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"src/application/adapter/exchange_rate"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_RateNotFound = "no exchange rate effective for the currency pair"
+	Err_Failed       = "exchange rate query failed"
+)
+
+type Handler struct {
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository
+	exchangeRateProvider   exchange_rate.IExchangeRateProvider
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository,
+	exchangeRateProvider exchange_rate.IExchangeRateProvider,
+) *Handler {
+	return &Handler{
+		exchangeRateRepository: exchangeRateRepository,
+		exchangeRateProvider:   exchangeRateProvider,
+	}
+}
+
+// resolvePair looks for a stored base->quote rate and falls back to the
+// inverse of a stored quote->base rate.
+func (h *Handler) resolvePair(
+	ctx context.Context,
+	base string,
+	quote string,
+	at time.Time,
+) (*entity.Rate, *time.Time, ResolutionEnum, error) {
+	direct, err := h.exchangeRateRepository.GetEffective(ctx, base, quote, at)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	inverse, err := h.exchangeRateRepository.GetEffective(ctx, quote, base, at)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	// when both directions exist the most recent one wins
+	if direct != nil && (inverse == nil || !inverse.EffectiveAt.After(direct.EffectiveAt)) {
+		return &direct.Rate, &direct.EffectiveAt, Resolution_Direct, nil
+	}
+
+	if inverse != nil && !inverse.Rate.IsZero() {
+		rate, err := inverse.Rate.Inverse()
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return &rate, &inverse.EffectiveAt, Resolution_Inverse, nil
+	}
+
+	return nil, nil, "", nil
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	base := strings.ToUpper(query.BaseCurrencyCode)
+	quote := strings.ToUpper(query.QuoteCurrencyCode)
+	at := time.Now().UTC()
+	if query.At != nil {
+		at = query.At.UTC()
+	}
+
+	if base == quote {
+		return &Result{
+			BaseCurrencyCode:  base,
+			QuoteCurrencyCode: quote,
+			Rate:              entity.MustParseRate("1"),
+			EffectiveAt:       at,
+			Resolution:        Resolution_Identity,
+		}, nil
+	}
+
+	rate, effectiveAt, resolution, err := h.resolvePair(ctx, base, quote, at)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if rate != nil {
+		return &Result{
+			BaseCurrencyCode:  base,
+			QuoteCurrencyCode: quote,
+			Rate:              *rate,
+			EffectiveAt:       *effectiveAt,
+			Resolution:        resolution,
+		}, nil
+	}
+
+	pivot := strings.ToUpper(h.exchangeRateProvider.Config().PivotCurrencyCode)
+	if pivot == base || pivot == quote {
+		return nil, exception.NewNotFound().WithMessage(Err_RateNotFound)
+	}
+
+	// cross rate: base -> pivot -> quote
+	baseToPivot, baseEffectiveAt, _, err := h.resolvePair(ctx, base, pivot, at)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	pivotToQuote, quoteEffectiveAt, _, err := h.resolvePair(ctx, pivot, quote, at)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if baseToPivot == nil || pivotToQuote == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_RateNotFound)
+	}
+
+	// a cross rate is only as recent as its oldest leg
+	crossEffectiveAt := *baseEffectiveAt
+	if quoteEffectiveAt.Before(crossEffectiveAt) {
+		crossEffectiveAt = *quoteEffectiveAt
+	}
+
+	return &Result{
+		BaseCurrencyCode:  base,
+		QuoteCurrencyCode: quote,
+		Rate:              baseToPivot.Multiply(*pivotToQuote),
+		EffectiveAt:       crossEffectiveAt,
+		Resolution:        Resolution_Cross,
+		PivotCurrencyCode: &pivot,
+	}, nil
+}
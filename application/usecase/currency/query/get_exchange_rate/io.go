@@ -0,0 +1,41 @@
+package get_exchange_rate
+
+import (
+	"time"
+
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type ResolutionEnum string
+
+const (
+	Resolution_Identity ResolutionEnum = "IDENTITY"
+	Resolution_Direct   ResolutionEnum = "DIRECT"
+	Resolution_Inverse  ResolutionEnum = "INVERSE"
+	Resolution_Cross    ResolutionEnum = "CROSS"
+)
+
+type Query struct {
+	BaseCurrencyCode  string     `json:"base_currency_code"`
+	QuoteCurrencyCode string     `json:"quote_currency_code"`
+	At                *time.Time `json:"at"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.BaseCurrencyCode).Required().Length(3),
+		validator.String(&q.QuoteCurrencyCode).Required().Length(3),
+	).Validate()
+}
+
+type Result struct {
+	BaseCurrencyCode  string         `json:"base_currency_code"`
+	QuoteCurrencyCode string         `json:"quote_currency_code"`
+	Rate              entity.Rate    `json:"rate"`
+	EffectiveAt       time.Time      `json:"effective_at"`
+	Resolution        ResolutionEnum `json:"resolution"`
+	PivotCurrencyCode *string        `json:"pivot_currency_code"`
+}
@@ -1,8 +1,48 @@
 package get_exchange_rate
 
+import (
+	"time"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	query := Query{
+		BaseCurrencyCode:  "USD",
+		QuoteCurrencyCode: "BRL",
+		At:                core.Ptr(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}
+	meta.Describe(&query,
+		meta.Description("Get the exchange rate effective at a point in time"),
+		meta.Example(&query),
+		meta.Field(&query.BaseCurrencyCode, meta.Description("ISO 4217 code of the currency being converted")),
+		meta.Field(&query.QuoteCurrencyCode, meta.Description("ISO 4217 code of the target currency")),
+		meta.Field(&query.At, meta.Description("Instant the rate must be effective at, defaults to now")),
+		meta.Throws[exception.NotFound](Err_RateNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		BaseCurrencyCode:  "USD",
+		QuoteCurrencyCode: "BRL",
+		Rate:              entity.MustParseRate("6.1834"),
+		EffectiveAt:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		Resolution:        Resolution_Cross,
+		PivotCurrencyCode: core.Ptr("EUR"),
+	}
+	meta.Describe(&result,
+		meta.Description("Exchange rate result"),
+		meta.Example(&result),
+		meta.Field(&result.Rate, meta.Description("Units of quote currency bought by one unit of base currency")),
+		meta.Field(&result.EffectiveAt, meta.Description("Instant the rate became effective")),
+		meta.Field(&result.Resolution, meta.Description("How the rate was obtained: IDENTITY, DIRECT, INVERSE or CROSS")),
+		meta.Field(&result.PivotCurrencyCode, meta.Description("Currency used to build a cross rate, null otherwise")))
+}
@@ -0,0 +1,39 @@
+package list_currency
+
+import (
+	"context"
+
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_Failed = "currency list query failed"
+)
+
+type Handler struct {
+	currencyRepository repository.ICurrencyRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	currencyRepository repository.ICurrencyRepository,
+) *Handler {
+	return &Handler{
+		currencyRepository: currencyRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	items, err := h.currencyRepository.List(ctx, !query.IncludeInactive)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Items: items}, nil
+}
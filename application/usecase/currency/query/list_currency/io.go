@@ -0,0 +1,11 @@
+package list_currency
+
+import "src/domain/entity"
+
+type Query struct {
+	IncludeInactive bool `json:"include_inactive"`
+}
+
+type Result struct {
+	Items []entity.CurrencyEntity `json:"items"`
+}
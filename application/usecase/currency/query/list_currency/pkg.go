@@ -1,8 +1,38 @@
 package list_currency
 
+import (
+	"time"
+
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	query := Query{}
+	meta.Describe(&query,
+		meta.Description("List currencies ordered by code"),
+		meta.Field(&query.IncludeInactive, meta.Description("Also return deactivated currencies")),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Items: []entity.CurrencyEntity{{
+			Code:      "BRL",
+			Name:      "Brazilian Real",
+			MinorUnit: 2,
+			Symbol:    "R$",
+			IsActive:  true,
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+	meta.Describe(&result,
+		meta.Description("Currency list result"),
+		meta.Example(&result),
+		meta.Field(&result.Items, meta.Description("Currencies")))
+}
@@ -0,0 +1,45 @@
+package list_exchange_rate_history
+
+import (
+	"context"
+
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_Failed = "exchange rate history query failed"
+)
+
+type Handler struct {
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	exchangeRateRepository repository.ICurrencyExchangeRateRepository,
+) *Handler {
+	return &Handler{
+		exchangeRateRepository: exchangeRateRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	result, err := h.exchangeRateRepository.ListHistory(ctx,
+		query.BaseCurrencyCode,
+		query.QuoteCurrencyCode,
+		query.From,
+		query.To,
+		query.Offset,
+		query.Limit)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package list_exchange_rate_history
+
+import (
+	"time"
+
+	"src/core/builder"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	BaseCurrencyCode  string     `json:"base_currency_code"`
+	QuoteCurrencyCode string     `json:"quote_currency_code"`
+	From              *time.Time `json:"from"`
+	To                *time.Time `json:"to"`
+	Offset            int64      `json:"offset"`
+	Limit             int64      `json:"limit"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.BaseCurrencyCode).Required().Length(3),
+		validator.String(&q.QuoteCurrencyCode).Required().Length(3),
+		validator.Number(&q.Offset).Integer().Min(0).Default(0),
+		validator.Number(&q.Limit).Integer().Min(1).Max(1000).Default(50),
+	).Validate()
+}
+
+type Result = builder.Result[entity.CurrencyExchangeRateEntity]
@@ -0,0 +1,35 @@
+package list_exchange_rate_history
+
+import (
+	"time"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{
+		BaseCurrencyCode:  "EUR",
+		QuoteCurrencyCode: "BRL",
+		From:              core.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		To:                core.Ptr(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)),
+		Limit:             50,
+	}
+	meta.Describe(&query,
+		meta.Description("List stored exchange rates of a currency pair, newest first"),
+		meta.Example(&query),
+		meta.Field(&query.BaseCurrencyCode, meta.Description("ISO 4217 code of the base currency")),
+		meta.Field(&query.QuoteCurrencyCode, meta.Description("ISO 4217 code of the quote currency")),
+		meta.Field(&query.From, meta.Description("Only rates effective at or after this instant")),
+		meta.Field(&query.To, meta.Description("Only rates effective at or before this instant")),
+		meta.Field(&query.Offset, meta.Description("Number of items to skip")),
+		meta.Field(&query.Limit, meta.Description("Maximum number of items to return")),
+		meta.Throws[exception.Internal](Err_Failed))
+}
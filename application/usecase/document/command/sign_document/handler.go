@@ -0,0 +1,197 @@
+package sign_document
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/certificate"
+	"src/application/adapter/crypto"
+	"src/application/adapter/storage"
+	"src/core"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	HashAlgorithm = "SHA-256"
+
+	signatureMimeType = "application/pkcs7-signature"
+)
+
+const (
+	Err_NotAuthenticated    = "signing a document requires an authenticated account"
+	Err_TenantNotVisible    = "caller is not a member of this tenant"
+	Err_CertificateNotFound = "certificate not found"
+	Err_CertificateRevoked  = "certificate is revoked"
+	Err_CertificateExpired  = "certificate is expired"
+	Err_DocumentNotFound    = "document not found or its content no longer matches the digest"
+	Err_Failed              = "document signing failed"
+)
+
+type Handler struct {
+	certificateAdapter       certificate.ICertificateAdapter
+	cryptoAdapter            crypto.ICryptoAdapter
+	storageAdapter           storage.IStorageAdapter
+	certificateRepository    repository.IAccountCertificateRepository
+	membershipRepository     repository.IMembershipRepository
+	signedDocumentRepository repository.ISignedDocumentRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	certificateAdapter certificate.ICertificateAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	storageAdapter storage.IStorageAdapter,
+	certificateRepository repository.IAccountCertificateRepository,
+	membershipRepository repository.IMembershipRepository,
+	signedDocumentRepository repository.ISignedDocumentRepository,
+) *Handler {
+	return &Handler{
+		certificateAdapter:       certificateAdapter,
+		cryptoAdapter:            cryptoAdapter,
+		storageAdapter:           storageAdapter,
+		certificateRepository:    certificateRepository,
+		membershipRepository:     membershipRepository,
+		signedDocumentRepository: signedDocumentRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	var tenantID *uuid.UUID
+	if command.TenantID != "" {
+		member, err := h.isMember(ctx, *info.AccountID, command.TenantID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if !member {
+			return nil, exception.NewForbidden().WithMessage(Err_TenantNotVisible)
+		}
+		tenantID = core.Ptr(uuid.MustParse(command.TenantID))
+	}
+
+	accountCertificate, err := h.certificateRepository.GetByID(ctx, *info.AccountID, uuid.MustParse(command.CertificateID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if accountCertificate == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_CertificateNotFound)
+	}
+	if accountCertificate.RevokedAt != nil {
+		return nil, exception.NewConflict().WithMessage(Err_CertificateRevoked)
+	}
+	if accountCertificate.ExpiresAt != nil && !accountCertificate.ExpiresAt.After(time.Now()) {
+		return nil, exception.NewUnprocessableEntity().WithMessage(Err_CertificateExpired)
+	}
+
+	container, password, err := h.openCertificate(accountCertificate)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	// the storage refuses to read a file whose content changed since upload
+	document, err := h.storageAdapter.Read(ctx, command.FilePath, command.Digest)
+	if err != nil {
+		return nil, exception.NewNotFound().WithCause(err).WithMessage(Err_DocumentNotFound)
+	}
+	defer document.Stream.Close()
+
+	signature, err := h.certificateAdapter.SignDetached(document.Stream, container, password)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if signature.Digest != command.Digest {
+		return nil, exception.NewNotFound().WithMessage(Err_DocumentNotFound)
+	}
+
+	id := uuid.New()
+	signaturePath := fmt.Sprintf("signature/%s.p7s", id)
+	signatureHash := sha256.Sum256(signature.Content)
+	if _, err := h.storageAdapter.Write(ctx, storage.WriteInput{
+		FilePath: signaturePath,
+		Stream:   bytes.NewReader(signature.Content),
+		MimeType: signatureMimeType,
+	}); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	signedDocument := &entity.SignedDocumentEntity{
+		ID:                     id,
+		CreatedAt:              time.Now().UTC(),
+		SignedAt:               signature.SignedAt,
+		DocumentPath:           command.FilePath,
+		DocumentHash:           signature.Digest,
+		HashAlgorithm:          HashAlgorithm,
+		SignaturePath:          signaturePath,
+		SignatureHash:          hex.EncodeToString(signatureHash[:]),
+		SignerSubject:          signature.Signer.Subject,
+		CertificateFingerprint: signature.Signer.Fingerprint,
+		AccountCertificateID:   accountCertificate.ID,
+		AccountID:              *info.AccountID,
+		TenantID:               tenantID,
+	}
+	if err := h.signedDocumentRepository.Insert(ctx, signedDocument); err != nil {
+		// best effort: an unreferenced signature file is harmless
+		_ = h.storageAdapter.Delete(ctx, signedDocument.SignaturePath, signedDocument.SignatureHash)
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{SignedDocument: *signedDocument}, nil
+}
+
+// openCertificate decrypts the stored PKCS#12 container and its password.
+func (h *Handler) openCertificate(accountCertificate *entity.AccountCertificateEntity) ([]byte, string, error) {
+	payload, err := h.cryptoAdapter.Decrypt(accountCertificate.EncryptedPayload)
+	if err != nil {
+		return nil, "", err
+	}
+	password, err := h.cryptoAdapter.Decrypt(accountCertificate.EncryptedPassword)
+	if err != nil {
+		return nil, "", err
+	}
+	encodedPayload, ok := payload.(string)
+	if !ok {
+		return nil, "", errors.New("certificate payload is not a string")
+	}
+	plainPassword, ok := password.(string)
+	if !ok {
+		return nil, "", errors.New("certificate password is not a string")
+	}
+	container, err := base64.StdEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, "", err
+	}
+	return container, plainPassword, nil
+}
+
+func (h *Handler) isMember(ctx context.Context, accountID uuid.UUID, tenantID string) (bool, error) {
+	memberships, err := h.membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.TenantID.String() == tenantID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
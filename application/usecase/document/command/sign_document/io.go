@@ -0,0 +1,32 @@
+package sign_document
+
+import (
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Command struct {
+	FilePath      string `json:"file_path"`
+	Digest        string `json:"digest"`
+	CertificateID string `json:"certificate_id"`
+	TenantID      string `json:"tenant_id"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.FilePath).Trim().Required().Max(1024),
+		validator.String(&c.Digest).Trim().Lowercase().Required().Hex().Length(64),
+		validator.String(&c.CertificateID).Required().GUID(),
+		validator.String(&c.TenantID).GUID(),
+	).Validate()
+}
+
+type Result struct {
+	SignedDocument entity.SignedDocumentEntity `json:"signed_document"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "signed_document", r.SignedDocument.ID.String()
+}
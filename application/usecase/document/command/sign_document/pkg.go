@@ -0,0 +1,34 @@
+package sign_document
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{
+		FilePath:      "contracts/2025/service-agreement.pdf",
+		Digest:        "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		CertificateID: "7a1e9c40-2b3d-4f5e-8a6b-9c0d1e2f3a4b",
+	}
+	meta.Describe(&command,
+		meta.Description("Sign a stored document with one of the caller's certificates, producing a detached CMS (PKCS #7) signature"),
+		meta.Example(&command),
+		meta.Field(&command.FilePath, meta.Description("Storage path of the document")),
+		meta.Field(&command.Digest, meta.Description("Hex SHA-256 digest returned by the storage when the document was uploaded")),
+		meta.Field(&command.CertificateID, meta.Description("Certificate whose key signs the document")),
+		meta.Field(&command.TenantID, meta.Description("Tenant the signed document belongs to; its members can search and verify it")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_TenantNotVisible),
+		meta.Throws[exception.NotFound](Err_CertificateNotFound),
+		meta.Throws[exception.NotFound](Err_DocumentNotFound),
+		meta.Throws[exception.Conflict](Err_CertificateRevoked),
+		meta.Throws[exception.UnprocessableEntity](Err_CertificateExpired),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -1,7 +1,14 @@
 package document
 
-import "src/application/usecase/document/query/search_signed_document"
+import (
+	"src/application/usecase/document/command/sign_document"
+	"src/application/usecase/document/query/search_signed_document"
+	"src/application/usecase/document/query/verify_signed_document"
+)
 
 func Register() {
+	sign_document.Register()
+
 	search_signed_document.Register()
+	verify_signed_document.Register()
 }
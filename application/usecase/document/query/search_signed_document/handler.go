@@ -0,0 +1,106 @@
+package search_signed_document
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "searching signed documents requires an authenticated account"
+	Err_TenantNotVisible = "signed documents of this tenant are not visible to the caller"
+	Err_Failed           = "signed document search failed"
+)
+
+type Handler struct {
+	membershipRepository     repository.IMembershipRepository
+	signedDocumentRepository repository.ISignedDocumentRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	membershipRepository repository.IMembershipRepository,
+	signedDocumentRepository repository.ISignedDocumentRepository,
+) *Handler {
+	return &Handler{
+		membershipRepository:     membershipRepository,
+		signedDocumentRepository: signedDocumentRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	// without a tenant the caller only sees what they signed; every member of
+	// a tenant sees the documents signed for it
+	if query.TenantID != "" {
+		member, err := h.isMember(ctx, *info.AccountID, query.TenantID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if !member {
+			return nil, exception.NewForbidden().WithMessage(Err_TenantNotVisible)
+		}
+	}
+
+	search := builder.NewQuery[entity.SignedDocumentEntity]().
+		Text(query.Text).
+		Where(func(e *entity.SignedDocumentEntity, q *builder.WhereBuilder[entity.SignedDocumentEntity]) {
+			if query.TenantID != "" {
+				q.Equal(&e.TenantID, query.TenantID)
+			} else {
+				q.Equal(&e.AccountID, info.AccountID.String())
+			}
+			if query.DocumentHash != "" {
+				q.Equal(&e.DocumentHash, query.DocumentHash)
+			}
+			if query.CertificateFingerprint != "" {
+				q.Equal(&e.CertificateFingerprint, query.CertificateFingerprint)
+			}
+			if query.From != nil {
+				q.GreaterEqual(&e.SignedAt, query.From.UTC())
+			}
+			if query.To != nil {
+				q.LowerEqual(&e.SignedAt, query.To.UTC())
+			}
+		}).
+		Sort(func(e *entity.SignedDocumentEntity, s *builder.SortBuilder[entity.SignedDocumentEntity]) {
+			s.Desc(&e.SignedAt)
+		}).
+		Offset(query.Offset).
+		Limit(query.Limit)
+
+	result, err := h.signedDocumentRepository.Search(ctx, search)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return result, nil
+}
+
+func (h *Handler) isMember(ctx context.Context, accountID uuid.UUID, tenantID string) (bool, error) {
+	memberships, err := h.membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.TenantID.String() == tenantID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,34 @@
+package search_signed_document
+
+import (
+	"time"
+
+	"src/core/builder"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	Text                   string     `json:"text"`
+	TenantID               string     `json:"tenant_id"`
+	DocumentHash           string     `json:"document_hash"`
+	CertificateFingerprint string     `json:"certificate_fingerprint"`
+	From                   *time.Time `json:"from"`
+	To                     *time.Time `json:"to"`
+	Offset                 int64      `json:"offset"`
+	Limit                  int64      `json:"limit"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.TenantID).GUID(),
+		validator.String(&q.DocumentHash).Trim().Lowercase().Hex(),
+		validator.String(&q.CertificateFingerprint).Trim().Lowercase().Hex(),
+		validator.Number(&q.Offset).Integer().Min(0).Default(0),
+		validator.Number(&q.Limit).Integer().Min(1).Max(1000).Default(50),
+	).Validate()
+}
+
+type Result = builder.Result[entity.SignedDocumentEntity]
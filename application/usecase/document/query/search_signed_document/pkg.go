@@ -1,8 +1,37 @@
 package search_signed_document
 
+import (
+	"time"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	query := Query{
+		TenantID: "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50",
+		From:     core.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Limit:    50,
+	}
+	meta.Describe(&query,
+		meta.Description("Search signed documents, most recently signed first. Without a tenant only documents signed by the caller are returned"),
+		meta.Example(&query),
+		meta.Field(&query.Text, meta.Description("Free text matched against the whole signed document record")),
+		meta.Field(&query.TenantID, meta.Description("Tenant whose signed documents are searched")),
+		meta.Field(&query.DocumentHash, meta.Description("Only signatures over a document with this hex SHA-256 digest")),
+		meta.Field(&query.CertificateFingerprint, meta.Description("Only signatures made with this certificate")),
+		meta.Field(&query.From, meta.Description("Only documents signed at or after this instant")),
+		meta.Field(&query.To, meta.Description("Only documents signed at or before this instant")),
+		meta.Field(&query.Offset, meta.Description("Number of items to skip")),
+		meta.Field(&query.Limit, meta.Description("Maximum number of items to return")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_TenantNotVisible),
+		meta.Throws[exception.Internal](Err_Failed))
+}
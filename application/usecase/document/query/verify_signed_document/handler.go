@@ -0,0 +1,160 @@
+package verify_signed_document
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/certificate"
+	"src/application/adapter/storage"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+// MaxContentSize bounds a copy sent for verification instead of the stored file.
+const MaxContentSize = 16 * 1024 * 1024
+
+// maxSignatureSize bounds the stored .p7s; it only holds the signer's chain.
+const maxSignatureSize = 1024 * 1024
+
+const (
+	Err_NotAuthenticated       = "verifying a signed document requires an authenticated account"
+	Err_SignedDocumentNotFound = "signed document not found"
+	Err_DocumentUnavailable    = "stored document is missing or was modified; send its content to verify a copy"
+	Err_Failed                 = "signed document verification failed"
+)
+
+type Handler struct {
+	certificateAdapter       certificate.ICertificateAdapter
+	storageAdapter           storage.IStorageAdapter
+	membershipRepository     repository.IMembershipRepository
+	signedDocumentRepository repository.ISignedDocumentRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	certificateAdapter certificate.ICertificateAdapter,
+	storageAdapter storage.IStorageAdapter,
+	membershipRepository repository.IMembershipRepository,
+	signedDocumentRepository repository.ISignedDocumentRepository,
+) *Handler {
+	return &Handler{
+		certificateAdapter:       certificateAdapter,
+		storageAdapter:           storageAdapter,
+		membershipRepository:     membershipRepository,
+		signedDocumentRepository: signedDocumentRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	signedDocument, err := h.signedDocumentRepository.GetByID(ctx, uuid.MustParse(query.SignedDocumentID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if signedDocument == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_SignedDocumentNotFound)
+	}
+	visible, err := h.isVisible(ctx, *info.AccountID, signedDocument)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !visible {
+		return nil, exception.NewNotFound().WithMessage(Err_SignedDocumentNotFound)
+	}
+
+	var content io.Reader
+	if len(query.Content) > 0 {
+		content = bytes.NewReader(query.Content)
+	} else {
+		document, err := h.storageAdapter.Read(ctx, signedDocument.DocumentPath, signedDocument.DocumentHash)
+		if err != nil {
+			return nil, exception.NewNotFound().WithCause(err).WithMessage(Err_DocumentUnavailable)
+		}
+		defer document.Stream.Close()
+		content = document.Stream
+	}
+
+	// hash what the verifier reads, then drain whatever it did not
+	hasher := sha256.New()
+	content = io.TeeReader(content, hasher)
+
+	signatureValid, err := h.verifySignature(ctx, signedDocument, content)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if _, err := io.Copy(io.Discard, content); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	computedHash := hex.EncodeToString(hasher.Sum(nil))
+	documentIntact := computedHash == signedDocument.DocumentHash
+	return &Result{
+		SignedDocument: *signedDocument,
+		ComputedHash:   computedHash,
+		DocumentIntact: documentIntact,
+		SignatureValid: signatureValid,
+		Valid:          documentIntact && signatureValid,
+	}, nil
+}
+
+// verifySignature checks the stored signature against content. A missing or
+// altered signature file, or one made by another certificate, is invalid.
+func (h *Handler) verifySignature(ctx context.Context, signedDocument *entity.SignedDocumentEntity, content io.Reader) (bool, error) {
+	stored, err := h.storageAdapter.Read(ctx, signedDocument.SignaturePath, signedDocument.SignatureHash)
+	if err != nil {
+		return false, nil
+	}
+	defer stored.Stream.Close()
+
+	signature, err := io.ReadAll(io.LimitReader(stored.Stream, maxSignatureSize))
+	if err != nil {
+		return false, err
+	}
+
+	verified, err := h.certificateAdapter.VerifyDetached(content, signature)
+	if errors.Is(err, certificate.ErrCertificate_InvalidSignature) || errors.Is(err, certificate.ErrCertificate_SignatureMismatch) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return verified.Signer.Fingerprint == signedDocument.CertificateFingerprint, nil
+}
+
+// isVisible lets the signer and, for tenant documents, every active member
+// of the tenant verify a signature.
+func (h *Handler) isVisible(ctx context.Context, accountID uuid.UUID, signedDocument *entity.SignedDocumentEntity) (bool, error) {
+	if signedDocument.AccountID == accountID {
+		return true, nil
+	}
+	if signedDocument.TenantID == nil {
+		return false, nil
+	}
+	memberships, err := h.membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, membership := range memberships {
+		if membership.TenantID == *signedDocument.TenantID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,28 @@
+package verify_signed_document
+
+import (
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	SignedDocumentID string `json:"signed_document_id"`
+	Content          []byte `json:"content"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.SignedDocumentID).Required().GUID(),
+		validator.Binary(&q.Content).Max(MaxContentSize),
+	).Validate()
+}
+
+type Result struct {
+	SignedDocument entity.SignedDocumentEntity `json:"signed_document"`
+	ComputedHash   string                      `json:"computed_hash"`
+	DocumentIntact bool                        `json:"document_intact"`
+	SignatureValid bool                        `json:"signature_valid"`
+	Valid          bool                        `json:"valid"`
+}
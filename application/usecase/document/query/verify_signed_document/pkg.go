@@ -0,0 +1,34 @@
+package verify_signed_document
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{SignedDocumentID: "3c5d7e9f-1a2b-4c6d-8e0f-a1b2c3d4e5f6"}
+	meta.Describe(&query,
+		meta.Description("Check a signed document: its content must match the digest recorded at signing time and the stored detached signature must be valid for it"),
+		meta.Example(&query),
+		meta.Field(&query.SignedDocumentID, meta.Description("Signed document to verify")),
+		meta.Field(&query.Content, meta.Description("Copy of the document to verify instead of the stored file")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_SignedDocumentNotFound),
+		meta.Throws[exception.NotFound](Err_DocumentUnavailable),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{ComputedHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", DocumentIntact: true, SignatureValid: true, Valid: true}
+	meta.Describe(&result,
+		meta.Description("Verification outcome"),
+		meta.Example(&result),
+		meta.Field(&result.ComputedHash, meta.Description("Hex SHA-256 digest of the verified content")),
+		meta.Field(&result.DocumentIntact, meta.Description("Whether the content matches the digest recorded at signing time")),
+		meta.Field(&result.SignatureValid, meta.Description("Whether the stored signature is valid for the content and made by the recorded certificate")),
+		meta.Field(&result.Valid, meta.Description("Both checks passed")))
+}
@@ -0,0 +1,57 @@
+package cancel_email_change
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/crypto"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_LinkInvalid = "email change link is invalid, expired or the change is already confirmed"
+	Err_Failed      = "email change cancellation failed"
+)
+
+type Handler struct {
+	cryptoAdapter         crypto.ICryptoAdapter
+	emailChangeRepository repository.IAccountEmailChangeRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	emailChangeRepository repository.IAccountEmailChangeRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:         cryptoAdapter,
+		emailChangeRepository: emailChangeRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	change, err := h.emailChangeRepository.GetByCancelTokenHash(ctx, h.cryptoAdapter.Hash(command.Token))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	now := time.Now().UTC()
+	if !change.Pending(now) {
+		return nil, exception.NewUnauthorized().WithMessage(Err_LinkInvalid)
+	}
+
+	cancelled, err := h.emailChangeRepository.Cancel(ctx, change.ID, now)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if cancelled == 0 {
+		return nil, exception.NewUnauthorized().WithMessage(Err_LinkInvalid)
+	}
+
+	return &Result{}, nil
+}
@@ -0,0 +1,17 @@
+package cancel_email_change
+
+import "src/core/validator"
+
+type Command struct {
+	Token string `json:"token"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Token).Trim().Required().Max(256),
+	).Validate()
+}
+
+type Result struct{}
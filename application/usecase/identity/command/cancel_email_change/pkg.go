@@ -0,0 +1,22 @@
+package cancel_email_change
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Token: "Zp4N7c1Qm8Rt2Wv5Xy0Ab3Cd6Ef9Gh2Jk5Lm8Np1Qr4"}
+	meta.Describe(&command,
+		meta.Description("Cancel a pending email change with the link sent to the current address"),
+		meta.Example(&command),
+		meta.Field(&command.Token, meta.Description("Token from the cancel link")),
+		meta.Throws[exception.Unauthorized](Err_LinkInvalid),
+		meta.Throws[exception.Internal](Err_Failed))
+}
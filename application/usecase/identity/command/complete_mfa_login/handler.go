@@ -0,0 +1,145 @@
+package complete_mfa_login
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/crypto"
+	"src/application/adapter/jwt"
+	"src/application/adapter/logger"
+	"src/application/adapter/mailer"
+	"src/application/adapter/totp"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_ChallengeInvalid = "login challenge is invalid or expired"
+	Err_InvalidCode      = "code is incorrect"
+	Err_TooManyAttempts  = "too many failed attempts, retry later"
+	Err_Failed           = "login failed"
+)
+
+type Handler struct {
+	cryptoAdapter          crypto.ICryptoAdapter
+	jwtAdapter             jwt.IJwtAdapter
+	accountRepository      repository.IAccountRepository
+	mfaRepository          repository.IAccountMfaRepository
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository
+	challengeRepository    repository.IAccountMfaChallengeRepository
+	sessionRepository      repository.IAccountSessionRepository
+	secondFactor           *authn.SecondFactor
+	throttle               *authn.Throttle
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	jwtAdapter jwt.IJwtAdapter,
+	loggerAdapter logger.ILoggerAdapter,
+	mailerAdapter mailer.IMailerAdapter,
+	totpAdapter totp.ITotpAdapter,
+	accountRepository repository.IAccountRepository,
+	mfaRepository repository.IAccountMfaRepository,
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository,
+	challengeRepository repository.IAccountMfaChallengeRepository,
+	sessionRepository repository.IAccountSessionRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:          cryptoAdapter,
+		jwtAdapter:             jwtAdapter,
+		accountRepository:      accountRepository,
+		mfaRepository:          mfaRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		challengeRepository:    challengeRepository,
+		sessionRepository:      sessionRepository,
+		secondFactor:           authn.NewSecondFactor(totpAdapter, cryptoAdapter, mfaRepository, recoveryCodeRepository),
+		throttle:               authn.NewThrottle(cacheAdapter, mailerAdapter, loggerAdapter),
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	challenge, err := h.challengeRepository.GetByTokenHash(ctx, h.cryptoAdapter.Hash(command.MfaToken))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if challenge == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_ChallengeInvalid)
+	}
+	if !challenge.ExpiresAt.After(time.Now()) || challenge.Attempts >= authn.ChallengeMaxAttempts {
+		if _, err := h.challengeRepository.Delete(ctx, challenge.ID); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		return nil, exception.NewUnauthorized().WithMessage(Err_ChallengeInvalid)
+	}
+
+	account, err := h.accountRepository.GetByID(ctx, challenge.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil || account.Status != entity.AccountStatus_Active {
+		return nil, exception.NewUnauthorized().WithMessage(Err_ChallengeInvalid)
+	}
+
+	wait, err := h.throttle.Check(ctx, account.Email)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if wait > 0 {
+		return nil, exception.NewTooManyRequests().WithRetryAfter(wait).WithMessage(Err_TooManyAttempts)
+	}
+
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, challenge.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	verified, err := h.secondFactor.Verify(ctx, mfa, command.Otp)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !verified {
+		if err := h.throttle.Fail(ctx, account.Email, account); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		counted, err := h.challengeRepository.Attempt(ctx, challenge.ID, challenge.Attempts)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if counted == 0 {
+			return nil, exception.NewUnauthorized().WithMessage(Err_ChallengeInvalid)
+		}
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidCode)
+	}
+
+	// the challenge is single use: only the request that deletes it signs in
+	deleted, err := h.challengeRepository.Delete(ctx, challenge.ID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if deleted == 0 {
+		return nil, exception.NewUnauthorized().WithMessage(Err_ChallengeInvalid)
+	}
+
+	token, err := authn.IssueSession(ctx, h.jwtAdapter, h.cryptoAdapter, h.sessionRepository, account)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if err := h.throttle.Reset(ctx, account.Email); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	remaining, err := h.recoveryCodeRepository.CountUnused(ctx, account.ID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Token: *token, RemainingRecoveryCodes: remaining}, nil
+}
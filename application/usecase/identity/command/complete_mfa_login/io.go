@@ -0,0 +1,25 @@
+package complete_mfa_login
+
+import (
+	"src/application/adapter/jwt"
+	"src/core/validator"
+)
+
+type Command struct {
+	MfaToken string `json:"mfa_token"`
+	Otp      string `json:"otp"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.MfaToken).Trim().Required().Max(128),
+		validator.String(&c.Otp).Trim().Required().Max(32),
+	).Validate()
+}
+
+type Result struct {
+	Token                  jwt.OpenIDToken `json:"token"`
+	RemainingRecoveryCodes int64           `json:"remaining_recovery_codes"`
+}
@@ -0,0 +1,32 @@
+package complete_mfa_login
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{MfaToken: "q3H8v0b2Yx1kP9sLmR4tWc7zN5eA6dJf", Otp: "492039"}
+	meta.Describe(&command,
+		meta.Description("Finish a login that returned an MFA challenge, with a TOTP code or an unused recovery code"),
+		meta.Example(&command),
+		meta.Field(&command.MfaToken, meta.Description("Challenge token returned by the login")),
+		meta.Field(&command.Otp, meta.Description("Current TOTP code, or a recovery code such as \"7KX2M-QH9TB\"")),
+		meta.Throws[exception.Unauthorized](Err_ChallengeInvalid),
+		meta.Throws[exception.Unauthorized](Err_InvalidCode),
+		meta.Throws[exception.TooManyRequests](Err_TooManyAttempts),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{RemainingRecoveryCodes: 9}
+	meta.Describe(&result,
+		meta.Description("Session tokens"),
+		meta.Example(&result),
+		meta.Field(&result.Token, meta.Description("Access and refresh tokens")),
+		meta.Field(&result.RemainingRecoveryCodes, meta.Description("Recovery codes left; regenerate them when running low")))
+}
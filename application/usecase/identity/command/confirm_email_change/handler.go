@@ -0,0 +1,126 @@
+package confirm_email_change
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/crypto"
+	"src/application/adapter/database"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_LinkInvalid  = "email change link is invalid or expired"
+	Err_EmailTaken   = "email is already used by another account"
+	Err_EmailMoved   = "account email changed since the request, ask again"
+	Err_Failed       = "email change failed"
+	Err_RevokeFailed = "email changed but other sessions could not be revoked"
+)
+
+type Handler struct {
+	cacheAdapter          cache.ICacheAdapter
+	cryptoAdapter         crypto.ICryptoAdapter
+	database              database.IDatabaseAdapter
+	accountRepository     repository.IAccountRepository
+	emailChangeRepository repository.IAccountEmailChangeRepository
+	sessionRepository     repository.IAccountSessionRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	database database.IDatabaseAdapter,
+	accountRepository repository.IAccountRepository,
+	emailChangeRepository repository.IAccountEmailChangeRepository,
+	sessionRepository repository.IAccountSessionRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:          cacheAdapter,
+		cryptoAdapter:         cryptoAdapter,
+		database:              database,
+		accountRepository:     accountRepository,
+		emailChangeRepository: emailChangeRepository,
+		sessionRepository:     sessionRepository,
+	}
+}
+
+// Handle swaps the account email in one transaction: the change is settled,
+// the new email checked free and the account moved only if it still has the
+// email the change was asked from. Every other session is then revoked; the
+// caller's own session survives when it belongs to the same account.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	change, err := h.emailChangeRepository.GetByConfirmTokenHash(ctx, h.cryptoAdapter.Hash(command.Token))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	now := time.Now().UTC()
+	if !change.Pending(now) {
+		return nil, exception.NewUnauthorized().WithMessage(Err_LinkInvalid)
+	}
+
+	uow, err := h.database.BeginTransaction(ctx)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	defer uow.Rollback(ctx)
+
+	confirmed, err := h.emailChangeRepository.Confirm(ctx, change.ID, now, uow)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if confirmed == 0 {
+		return nil, exception.NewUnauthorized().WithMessage(Err_LinkInvalid)
+	}
+
+	// the unique email index catches a change racing for the same address,
+	// the count gives the readable error in the common case
+	taken, err := h.accountRepository.CountByEmail(ctx, change.NewEmail, uow)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if taken > 0 {
+		return nil, exception.NewConflict().WithMessage(Err_EmailTaken)
+	}
+
+	moved, err := h.accountRepository.UpdateEmail(ctx, change.AccountID, change.PreviousEmail, change.NewEmail, now, uow)
+	if errors.Is(err, repository.ErrAccount_EmailTaken) {
+		return nil, exception.NewConflict().WithMessage(Err_EmailTaken)
+	}
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if moved == 0 {
+		return nil, exception.NewConflict().WithMessage(Err_EmailMoved)
+	}
+
+	keep := ""
+	if info := common.GetRequestInfo(ctx); info.AccountID != nil && *info.AccountID == change.AccountID {
+		keep = info.SessionKey
+	}
+	sessions, err := authn.RevokeOtherSessions(ctx, h.sessionRepository, change.AccountID, keep, now, uow)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	events, err := authn.MarkSessionsRevoked(ctx, h.cacheAdapter, sessions, now)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_RevokeFailed)
+	}
+
+	return &Result{Email: change.NewEmail, RevokedSessions: len(sessions), events: events}, nil
+}
@@ -0,0 +1,31 @@
+package confirm_email_change
+
+import (
+	"src/core/validator"
+	"src/domain/event"
+)
+
+type Command struct {
+	Token string `json:"token"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Token).Trim().Required().Max(256),
+	).Validate()
+}
+
+type Result struct {
+	Email           string `json:"email"`
+	RevokedSessions int    `json:"revoked_sessions"`
+
+	events []event.IDomainEvent
+}
+
+var _ event.IEventSource = (*Result)(nil)
+
+func (r *Result) DomainEvents() []event.IDomainEvent {
+	return r.events
+}
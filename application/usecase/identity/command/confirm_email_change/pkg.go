@@ -0,0 +1,32 @@
+package confirm_email_change
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Token: "q3H8v0b2Yx1kP9sLmR4tWc7zN5eA6dJfT2uV8wX0yZa"}
+	meta.Describe(&command,
+		meta.Description("Confirm an email change with the link sent to the new address. The account moves to it and every other session is signed out"),
+		meta.Example(&command),
+		meta.Field(&command.Token, meta.Description("Token from the confirmation link")),
+		meta.Throws[exception.Unauthorized](Err_LinkInvalid),
+		meta.Throws[exception.Conflict](Err_EmailTaken),
+		meta.Throws[exception.Conflict](Err_EmailMoved),
+		meta.Throws[exception.Internal](Err_Failed),
+		meta.Throws[exception.Internal](Err_RevokeFailed))
+
+	result := Result{Email: "jane.smith@example.com", RevokedSessions: 2}
+	meta.Describe(&result,
+		meta.Description("The account's new email"),
+		meta.Example(&result),
+		meta.Field(&result.Email, meta.Description("Email the account now uses")),
+		meta.Field(&result.RevokedSessions, meta.Description("Number of other sessions signed out")))
+}
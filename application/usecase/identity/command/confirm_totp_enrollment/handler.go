@@ -0,0 +1,99 @@
+package confirm_totp_enrollment
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/crypto"
+	"src/application/adapter/totp"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "confirming MFA requires an authenticated account"
+	Err_NotStarted       = "MFA enrollment was not started"
+	Err_AlreadyEnabled   = "MFA is already enabled"
+	Err_InvalidCode      = "code is incorrect"
+	Err_Failed           = "MFA confirmation failed"
+)
+
+type Handler struct {
+	cryptoAdapter          crypto.ICryptoAdapter
+	totpAdapter            totp.ITotpAdapter
+	mfaRepository          repository.IAccountMfaRepository
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository
+	secondFactor           *authn.SecondFactor
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	totpAdapter totp.ITotpAdapter,
+	mfaRepository repository.IAccountMfaRepository,
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:          cryptoAdapter,
+		totpAdapter:            totpAdapter,
+		mfaRepository:          mfaRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		secondFactor:           authn.NewSecondFactor(totpAdapter, cryptoAdapter, mfaRepository, recoveryCodeRepository),
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if mfa == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_NotStarted)
+	}
+	if mfa.Enabled() {
+		return nil, exception.NewConflict().WithMessage(Err_AlreadyEnabled)
+	}
+
+	secret, err := h.secondFactor.Secret(mfa)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	now := time.Now()
+	step, ok := h.totpAdapter.Validate(secret, command.Otp, now)
+	if !ok {
+		return nil, exception.NewUnprocessableEntity().WithMessage(Err_InvalidCode)
+	}
+
+	confirmed, err := h.mfaRepository.Confirm(ctx, *info.AccountID, step, now)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if confirmed == 0 {
+		return nil, exception.NewConflict().WithMessage(Err_AlreadyEnabled)
+	}
+
+	codes, entities, err := authn.NewRecoveryCodes(h.cryptoAdapter, *info.AccountID, now)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if _, err := h.recoveryCodeRepository.DeleteByAccountID(ctx, *info.AccountID); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if err := h.recoveryCodeRepository.Insert(ctx, entities); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,26 @@
+package confirm_totp_enrollment
+
+import (
+	"src/core/cqrs"
+	"src/core/validator"
+)
+
+type Command struct {
+	Otp string `json:"otp"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Otp).Trim().Required().Max(8),
+	).Validate()
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
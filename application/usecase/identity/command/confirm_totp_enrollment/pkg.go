@@ -0,0 +1,31 @@
+package confirm_totp_enrollment
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Otp: "492039"}
+	meta.Describe(&command,
+		meta.Description("Enable MFA by proving the authenticator app produces valid codes. Returns the recovery codes, which are shown only once"),
+		meta.Example(&command),
+		meta.Field(&command.Otp, meta.Description("Current TOTP code from the authenticator app")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotStarted),
+		meta.Throws[exception.Conflict](Err_AlreadyEnabled),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidCode),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{RecoveryCodes: []string{"7KX2M-QH9TB", "C4NWE-8RZ3F"}}
+	meta.Describe(&result,
+		meta.Description("Single-use recovery codes"),
+		meta.Example(&result),
+		meta.Field(&result.RecoveryCodes, meta.Description("Each code can replace a TOTP code once")))
+}
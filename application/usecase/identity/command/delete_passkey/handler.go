@@ -0,0 +1,53 @@
+package delete_passkey
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "deleting a passkey requires an authenticated account"
+	Err_PasskeyNotFound  = "passkey not found"
+	Err_Failed           = "passkey deletion failed"
+)
+
+type Handler struct {
+	credentialRepository repository.IAccountCredentialRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	credentialRepository repository.IAccountCredentialRepository,
+) *Handler {
+	return &Handler{
+		credentialRepository: credentialRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	deleted, err := h.credentialRepository.SoftDelete(ctx, uuid.MustParse(command.PasskeyID), *info.AccountID, time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if deleted == 0 {
+		return nil, exception.NewNotFound().WithMessage(Err_PasskeyNotFound)
+	}
+
+	return &Result{}, nil
+}
@@ -0,0 +1,21 @@
+package delete_passkey
+
+import "src/core/validator"
+
+type Command struct {
+	PasskeyID string `json:"passkey_id"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.PasskeyID).Trim().Lowercase().Required().GUID(),
+	).Validate()
+}
+
+func (c *Command) ActivityTarget() (string, string) {
+	return "passkey", c.PasskeyID
+}
+
+type Result struct{}
@@ -0,0 +1,23 @@
+package delete_passkey
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{PasskeyID: "3c5e7a91-0b2d-4f6e-9a8b-1c2d3e4f5a6b"}
+	meta.Describe(&command,
+		meta.Description("Remove one of the caller's passkeys. The authenticator keeps the key until it is deleted there too"),
+		meta.Example(&command),
+		meta.Field(&command.PasskeyID, meta.Description("Passkey to remove")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_PasskeyNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+}
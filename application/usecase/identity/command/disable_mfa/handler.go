@@ -0,0 +1,91 @@
+package disable_mfa
+
+import (
+	"context"
+
+	"src/application/adapter/crypto"
+	"src/application/adapter/totp"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "disabling MFA requires an authenticated account"
+	Err_NotEnabled       = "MFA is not enabled"
+	Err_RequiredByTenant = "a tenant of the account requires MFA"
+	Err_InvalidCode      = "code is incorrect"
+	Err_Failed           = "disabling MFA failed"
+)
+
+type Handler struct {
+	mfaRepository                 repository.IAccountMfaRepository
+	recoveryCodeRepository        repository.IAccountRecoveryCodeRepository
+	membershipRepository          repository.IMembershipRepository
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+	secondFactor                  *authn.SecondFactor
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	totpAdapter totp.ITotpAdapter,
+	mfaRepository repository.IAccountMfaRepository,
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository,
+	membershipRepository repository.IMembershipRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+) *Handler {
+	return &Handler{
+		mfaRepository:                 mfaRepository,
+		recoveryCodeRepository:        recoveryCodeRepository,
+		membershipRepository:          membershipRepository,
+		tenantConfigurationRepository: tenantConfigurationRepository,
+		secondFactor:                  authn.NewSecondFactor(totpAdapter, cryptoAdapter, mfaRepository, recoveryCodeRepository),
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !mfa.Enabled() {
+		return nil, exception.NewNotFound().WithMessage(Err_NotEnabled)
+	}
+
+	required, err := authn.RequiredByTenant(ctx, h.membershipRepository, h.tenantConfigurationRepository, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if required {
+		return nil, exception.NewForbidden().WithMessage(Err_RequiredByTenant)
+	}
+
+	verified, err := h.secondFactor.Verify(ctx, mfa, command.Otp)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !verified {
+		return nil, exception.NewUnprocessableEntity().WithMessage(Err_InvalidCode)
+	}
+
+	if _, err := h.mfaRepository.DeleteByAccountID(ctx, *info.AccountID); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if _, err := h.recoveryCodeRepository.DeleteByAccountID(ctx, *info.AccountID); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{}, nil
+}
@@ -0,0 +1,25 @@
+package disable_mfa
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Otp: "492039"}
+	meta.Describe(&command,
+		meta.Description("Turn MFA off for the caller and discard the recovery codes. Not allowed while a tenant of the account requires MFA"),
+		meta.Example(&command),
+		meta.Field(&command.Otp, meta.Description("Current TOTP code or an unused recovery code")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotEnabled),
+		meta.Throws[exception.Forbidden](Err_RequiredByTenant),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidCode),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,85 @@
+package end_impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/cache"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotImpersonating = "the caller is not impersonating an account"
+	Err_AlreadyEnded     = "impersonation already ended"
+	Err_Failed           = "ending the impersonation failed"
+)
+
+type Handler struct {
+	cacheAdapter            cache.ICacheAdapter
+	impersonationRepository repository.IImpersonationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	impersonationRepository repository.IImpersonationRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:            cacheAdapter,
+		impersonationRepository: impersonationRepository,
+	}
+}
+
+// Handle ends the caller's impersonation before its token expires; the
+// token is refused from then on, like a revoked session.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.ImpersonatorAccountID == nil {
+		return nil, exception.NewForbidden().WithMessage(Err_NotImpersonating)
+	}
+	id, err := uuid.Parse(info.SessionKey)
+	if err != nil {
+		return nil, exception.NewForbidden().WithMessage(Err_NotImpersonating)
+	}
+
+	impersonation, err := h.impersonationRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if impersonation == nil {
+		return nil, exception.NewForbidden().WithMessage(Err_NotImpersonating)
+	}
+
+	now := time.Now().UTC()
+	ended, err := h.impersonationRepository.End(ctx, impersonation.ID, now)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if ended == 0 {
+		return nil, exception.NewConflict().WithMessage(Err_AlreadyEnded)
+	}
+
+	// the impersonation token behaves like a session of the account, so the
+	// same marker refuses it and the same event closes its streams
+	events, err := authn.MarkSessionsRevoked(ctx, h.cacheAdapter, []entity.AccountSessionEntity{{
+		ID:        impersonation.ID,
+		ExpiresAt: &impersonation.ExpiresAt,
+		AccountID: impersonation.AccountID,
+	}}, now)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{ImpersonationID: impersonation.ID, AccountID: impersonation.AccountID, events: events}, nil
+}
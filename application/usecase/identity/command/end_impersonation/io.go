@@ -0,0 +1,26 @@
+package end_impersonation
+
+import (
+	"github.com/google/uuid"
+
+	"src/domain/event"
+)
+
+type Command struct{}
+
+type Result struct {
+	ImpersonationID uuid.UUID `json:"impersonation_id"`
+	AccountID       uuid.UUID `json:"account_id"`
+
+	events []event.IDomainEvent
+}
+
+var _ event.IEventSource = (*Result)(nil)
+
+func (r *Result) DomainEvents() []event.IDomainEvent {
+	return r.events
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "account", r.AccountID.String()
+}
@@ -0,0 +1,34 @@
+package end_impersonation
+
+import (
+	"github.com/google/uuid"
+
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{}
+	meta.Describe(&command,
+		meta.Description("End the impersonation of the calling token before it expires"),
+		meta.Example(&command),
+		meta.Throws[exception.Forbidden](Err_NotImpersonating),
+		meta.Throws[exception.Conflict](Err_AlreadyEnded),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		ImpersonationID: uuid.MustParse("6f1c2d3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f"),
+		AccountID:       uuid.MustParse("0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"),
+	}
+	meta.Describe(&result,
+		meta.Description("The ended impersonation"),
+		meta.Example(&result),
+		meta.Field(&result.ImpersonationID, meta.Description("Impersonation id")),
+		meta.Field(&result.AccountID, meta.Description("Account that was impersonated")))
+}
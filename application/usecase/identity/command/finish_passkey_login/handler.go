@@ -0,0 +1,184 @@
+package finish_passkey_login
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/crypto"
+	"src/application/adapter/jwt"
+	"src/application/adapter/webauthn"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_CeremonyExpired  = "passkey login expired, start a new one"
+	Err_InvalidPasskey   = "passkey is unknown or its response is invalid"
+	Err_ClonedPasskey    = "passkey counter went backwards, the authenticator may be cloned"
+	Err_AccountNotActive = "account is not active"
+	Err_Failed           = "passkey login failed"
+)
+
+type Handler struct {
+	cacheAdapter                  cache.ICacheAdapter
+	cryptoAdapter                 crypto.ICryptoAdapter
+	jwtAdapter                    jwt.IJwtAdapter
+	webAuthnAdapter               webauthn.IWebAuthnAdapter
+	accountRepository             repository.IAccountRepository
+	credentialRepository          repository.IAccountCredentialRepository
+	mfaRepository                 repository.IAccountMfaRepository
+	challengeRepository           repository.IAccountMfaChallengeRepository
+	sessionRepository             repository.IAccountSessionRepository
+	membershipRepository          repository.IMembershipRepository
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	jwtAdapter jwt.IJwtAdapter,
+	webAuthnAdapter webauthn.IWebAuthnAdapter,
+	accountRepository repository.IAccountRepository,
+	credentialRepository repository.IAccountCredentialRepository,
+	mfaRepository repository.IAccountMfaRepository,
+	challengeRepository repository.IAccountMfaChallengeRepository,
+	sessionRepository repository.IAccountSessionRepository,
+	membershipRepository repository.IMembershipRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:                  cacheAdapter,
+		cryptoAdapter:                 cryptoAdapter,
+		jwtAdapter:                    jwtAdapter,
+		webAuthnAdapter:               webAuthnAdapter,
+		accountRepository:             accountRepository,
+		credentialRepository:          credentialRepository,
+		mfaRepository:                 mfaRepository,
+		challengeRepository:           challengeRepository,
+		sessionRepository:             sessionRepository,
+		membershipRepository:          membershipRepository,
+		tenantConfigurationRepository: tenantConfigurationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	challenge, found, err := authn.TakeChallenge(ctx, h.cacheAdapter, authn.PasskeyLoginKey(command.CeremonyID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !found {
+		return nil, exception.NewUnauthorized().WithMessage(Err_CeremonyExpired)
+	}
+
+	response, userHandle, err := decode(command)
+	if err != nil {
+		return nil, exception.NewUnauthorized().WithCause(err).WithMessage(Err_InvalidPasskey)
+	}
+
+	passkey, err := h.credentialRepository.GetByProviderSubject(ctx, entity.AccountCredentialType_Passkey, command.CredentialID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if passkey == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidPasskey)
+	}
+	// a discoverable credential names its owner, which must be the one on record
+	if userHandle != nil && !bytes.Equal(userHandle, passkey.AccountID[:]) {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidPasskey)
+	}
+
+	publicKey, err := base64.RawURLEncoding.DecodeString(passkey.PublicKey)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	assertion, err := h.webAuthnAdapter.VerifyAssertion(challenge, publicKey, uint32(passkey.SignCount), response)
+	if errors.Is(err, webauthn.ErrWebAuthn_SignCountRegression) {
+		return nil, exception.NewUnauthorized().WithCause(err).WithMessage(Err_ClonedPasskey)
+	}
+	if err != nil {
+		return nil, exception.NewUnauthorized().WithCause(err).WithMessage(Err_InvalidPasskey)
+	}
+
+	// losing the race against a concurrent assertion counts as a replay
+	affected, err := h.credentialRepository.UpdateSignCount(ctx, passkey.ID, passkey.SignCount, int64(assertion.SignCount), time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if affected == 0 {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidPasskey)
+	}
+
+	account, err := h.accountRepository.GetByID(ctx, passkey.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_InvalidPasskey)
+	}
+	if account.Status != entity.AccountStatus_Active {
+		return nil, exception.NewForbidden().WithMessage(Err_AccountNotActive)
+	}
+
+	required := false
+	if !assertion.UserVerified {
+		mfa, err := h.mfaRepository.GetByAccountID(ctx, account.ID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if mfa.Enabled() {
+			token, mfaChallenge, err := authn.OpenChallenge(ctx, h.cryptoAdapter, h.challengeRepository, account.ID)
+			if err != nil {
+				return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+			}
+			return &Result{MfaRequired: true, MfaToken: token, MfaExpiresAt: &mfaChallenge.ExpiresAt}, nil
+		}
+		if required, err = authn.RequiredByTenant(ctx, h.membershipRepository, h.tenantConfigurationRepository, account.ID); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+	}
+
+	token, err := authn.IssueSession(ctx, h.jwtAdapter, h.cryptoAdapter, h.sessionRepository, account)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Token: token, MfaEnrollmentRequired: required}, nil
+}
+
+func decode(command *Command) (*webauthn.AssertionResponse, []byte, error) {
+	clientDataJSON, err := base64.RawURLEncoding.DecodeString(command.ClientDataJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	authenticatorData, err := base64.RawURLEncoding.DecodeString(command.AuthenticatorData)
+	if err != nil {
+		return nil, nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(command.Signature)
+	if err != nil {
+		return nil, nil, err
+	}
+	var userHandle []byte
+	if command.UserHandle != "" {
+		if userHandle, err = base64.RawURLEncoding.DecodeString(command.UserHandle); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &webauthn.AssertionResponse{
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authenticatorData,
+		Signature:         signature,
+	}, userHandle, nil
+}
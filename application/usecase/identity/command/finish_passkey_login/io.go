@@ -0,0 +1,42 @@
+package finish_passkey_login
+
+import (
+	"time"
+
+	"src/application/adapter/jwt"
+	"src/core/validator"
+)
+
+// Command carries the AuthenticatorAssertionResponse, binary fields base64url encoded.
+type Command struct {
+	CeremonyID        string `json:"ceremony_id"`
+	CredentialID      string `json:"credential_id"`
+	ClientDataJSON    string `json:"client_data_json"`
+	AuthenticatorData string `json:"authenticator_data"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"user_handle"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.CeremonyID).Trim().Lowercase().Required().GUID(),
+		validator.String(&c.CredentialID).Trim().Required().Max(1400),
+		validator.String(&c.ClientDataJSON).Trim().Required().Max(4096),
+		validator.String(&c.AuthenticatorData).Trim().Required().Max(4096),
+		validator.String(&c.Signature).Trim().Required().Max(1024),
+		validator.String(&c.UserHandle).Trim().Max(128),
+	).Validate()
+}
+
+// Result has the shape of login_with_email_and_password. A passkey that
+// verified its user counts as a second factor; otherwise an account with MFA
+// enabled still answers the challenge with complete_mfa_login.
+type Result struct {
+	Token                 *jwt.OpenIDToken `json:"token,omitempty"`
+	MfaRequired           bool             `json:"mfa_required"`
+	MfaToken              string           `json:"mfa_token,omitempty"`
+	MfaExpiresAt          *time.Time       `json:"mfa_expires_at,omitempty"`
+	MfaEnrollmentRequired bool             `json:"mfa_enrollment_required"`
+}
@@ -0,0 +1,37 @@
+package finish_passkey_login
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{
+		CeremonyID:        "6f1c2a9e-3d4b-4e5f-8a7b-9c0d1e2f3a4b",
+		CredentialID:      "hZ3r0u8n2Kq1bXoM9cYtVw",
+		ClientDataJSON:    "eyJ0eXBlIjoid2ViYXV0aG4uZ2V0IiwiY2hhbGxlbmdlIjoiLi4uIiwib3JpZ2luIjoiaHR0cHM6Ly9leGFtcGxlLmNvbSJ9",
+		AuthenticatorData: "SZYN5YgOjGh0NBcPZHZgW4_krrmihjLHmVzzuoMdl2MFAAAAAQ",
+		Signature:         "MEUCIQ...",
+		UserHandle:        "b2YxYzJhOWUzZDRiNGU1Zg",
+	}
+	meta.Describe(&command,
+		meta.Description("Sign in with the assertion for a ceremony opened by start_passkey_login"),
+		meta.Example(&command),
+		meta.Field(&command.CeremonyID, meta.Description("Returned by start_passkey_login, usable once")),
+		meta.Field(&command.CredentialID, meta.Description("PublicKeyCredential.id, base64url")),
+		meta.Field(&command.ClientDataJSON, meta.Description("response.clientDataJSON, base64url")),
+		meta.Field(&command.AuthenticatorData, meta.Description("response.authenticatorData, base64url")),
+		meta.Field(&command.Signature, meta.Description("response.signature, base64url")),
+		meta.Field(&command.UserHandle, meta.Description("response.userHandle, base64url, sent by discoverable passkeys")),
+		meta.Throws[exception.Unauthorized](Err_CeremonyExpired),
+		meta.Throws[exception.Unauthorized](Err_InvalidPasskey),
+		meta.Throws[exception.Unauthorized](Err_ClonedPasskey),
+		meta.Throws[exception.Forbidden](Err_AccountNotActive),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,116 @@
+package finish_passkey_registration
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/webauthn"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated  = "registering a passkey requires an authenticated account"
+	Err_NotStarted        = "no passkey registration is open, start a new one"
+	Err_InvalidEncoding   = "client_data_json and attestation_object must be base64url"
+	Err_InvalidResponse   = "authenticator response does not match the registration"
+	Err_UnsupportedDevice = "authenticator uses an unsupported attestation or key type"
+	Err_AlreadyRegistered = "passkey is already registered"
+	Err_Failed            = "passkey registration failed"
+)
+
+type Handler struct {
+	cacheAdapter         cache.ICacheAdapter
+	webAuthnAdapter      webauthn.IWebAuthnAdapter
+	credentialRepository repository.IAccountCredentialRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	webAuthnAdapter webauthn.IWebAuthnAdapter,
+	credentialRepository repository.IAccountCredentialRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:         cacheAdapter,
+		webAuthnAdapter:      webAuthnAdapter,
+		credentialRepository: credentialRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	clientDataJSON, err := base64.RawURLEncoding.DecodeString(command.ClientDataJSON)
+	if err != nil {
+		return nil, exception.NewUnprocessableEntity().WithCause(err).WithMessage(Err_InvalidEncoding)
+	}
+	attestationObject, err := base64.RawURLEncoding.DecodeString(command.AttestationObject)
+	if err != nil {
+		return nil, exception.NewUnprocessableEntity().WithCause(err).WithMessage(Err_InvalidEncoding)
+	}
+
+	challenge, found, err := authn.TakeChallenge(ctx, h.cacheAdapter, authn.PasskeyRegistrationKey(*info.AccountID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !found {
+		return nil, exception.NewNotFound().WithMessage(Err_NotStarted)
+	}
+
+	credential, err := h.webAuthnAdapter.VerifyRegistration(challenge, &webauthn.AttestationResponse{
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+	})
+	if errors.Is(err, webauthn.ErrWebAuthn_UnsupportedAttestation) || errors.Is(err, webauthn.ErrWebAuthn_UnsupportedKey) {
+		return nil, exception.NewUnprocessableEntity().WithCause(err).WithMessage(Err_UnsupportedDevice)
+	}
+	if err != nil {
+		return nil, exception.NewUnprocessableEntity().WithCause(err).WithMessage(Err_InvalidResponse)
+	}
+
+	credentialID := base64.RawURLEncoding.EncodeToString(credential.ID)
+	existing, err := h.credentialRepository.GetByProviderSubject(ctx, entity.AccountCredentialType_Passkey, credentialID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if existing != nil {
+		return nil, exception.NewConflict().WithMessage(Err_AlreadyRegistered)
+	}
+
+	now := time.Now().UTC()
+	passkey := &entity.AccountCredentialEntity{
+		ID:              uuid.New(),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		CredentialType:  string(entity.AccountCredentialType_Passkey),
+		ProviderSubject: credentialID,
+		Name:            command.Name,
+		PublicKey:       base64.RawURLEncoding.EncodeToString(credential.PublicKey),
+		SignCount:       int64(credential.SignCount),
+		Aaguid:          credential.Aaguid,
+		BackupEligible:  credential.BackupEligible,
+		AccountID:       *info.AccountID,
+	}
+	if err := h.credentialRepository.Insert(ctx, passkey); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Passkey: authn.NewPasskey(passkey)}, nil
+}
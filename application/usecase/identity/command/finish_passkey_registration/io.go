@@ -0,0 +1,31 @@
+package finish_passkey_registration
+
+import (
+	"src/application/usecase/identity/internal/authn"
+	"src/core/validator"
+)
+
+// Command carries the AuthenticatorAttestationResponse, binary fields base64url encoded.
+type Command struct {
+	Name              string `json:"name"`
+	ClientDataJSON    string `json:"client_data_json"`
+	AttestationObject string `json:"attestation_object"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Name).Trim().Default("Passkey").Max(64),
+		validator.String(&c.ClientDataJSON).Trim().Required().Max(4096),
+		validator.String(&c.AttestationObject).Trim().Required().Max(65536),
+	).Validate()
+}
+
+type Result struct {
+	Passkey authn.Passkey `json:"passkey"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "passkey", r.Passkey.ID.String()
+}
@@ -0,0 +1,33 @@
+package finish_passkey_registration
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{
+		Name:              "MacBook Touch ID",
+		ClientDataJSON:    "eyJ0eXBlIjoid2ViYXV0aG4uY3JlYXRlIiwiY2hhbGxlbmdlIjoiLi4uIiwib3JpZ2luIjoiaHR0cHM6Ly9leGFtcGxlLmNvbSJ9",
+		AttestationObject: "o2NmbXRkbm9uZWdhdHRTdG10oGhhdXRoRGF0YVjF...",
+	}
+	meta.Describe(&command,
+		meta.Description("Finish the passkey registration opened by start_passkey_registration. Attestation formats \"none\" and \"packed\" are accepted"),
+		meta.Example(&command),
+		meta.Field(&command.Name, meta.Description("Label shown in the passkey list")),
+		meta.Field(&command.ClientDataJSON, meta.Description("response.clientDataJSON, base64url")),
+		meta.Field(&command.AttestationObject, meta.Description("response.attestationObject, base64url")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotStarted),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidEncoding),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidResponse),
+		meta.Throws[exception.UnprocessableEntity](Err_UnsupportedDevice),
+		meta.Throws[exception.Conflict](Err_AlreadyRegistered),
+		meta.Throws[exception.Internal](Err_Failed))
+}
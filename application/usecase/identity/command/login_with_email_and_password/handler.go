@@ -0,0 +1,133 @@
+package login_with_email_and_password
+
+import (
+	"context"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/crypto"
+	"src/application/adapter/jwt"
+	"src/application/adapter/logger"
+	"src/application/adapter/mailer"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_InvalidCredentials = "email or password is incorrect"
+	Err_AccountNotActive   = "account is not active"
+	Err_TooManyAttempts    = "too many failed attempts, retry later"
+	Err_Failed             = "login failed"
+)
+
+type Handler struct {
+	cryptoAdapter                 crypto.ICryptoAdapter
+	jwtAdapter                    jwt.IJwtAdapter
+	accountRepository             repository.IAccountRepository
+	credentialRepository          repository.IAccountCredentialRepository
+	mfaRepository                 repository.IAccountMfaRepository
+	challengeRepository           repository.IAccountMfaChallengeRepository
+	sessionRepository             repository.IAccountSessionRepository
+	membershipRepository          repository.IMembershipRepository
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+	throttle                      *authn.Throttle
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	jwtAdapter jwt.IJwtAdapter,
+	loggerAdapter logger.ILoggerAdapter,
+	mailerAdapter mailer.IMailerAdapter,
+	accountRepository repository.IAccountRepository,
+	credentialRepository repository.IAccountCredentialRepository,
+	mfaRepository repository.IAccountMfaRepository,
+	challengeRepository repository.IAccountMfaChallengeRepository,
+	sessionRepository repository.IAccountSessionRepository,
+	membershipRepository repository.IMembershipRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:                 cryptoAdapter,
+		jwtAdapter:                    jwtAdapter,
+		accountRepository:             accountRepository,
+		credentialRepository:          credentialRepository,
+		mfaRepository:                 mfaRepository,
+		challengeRepository:           challengeRepository,
+		sessionRepository:             sessionRepository,
+		membershipRepository:          membershipRepository,
+		tenantConfigurationRepository: tenantConfigurationRepository,
+		throttle:                      authn.NewThrottle(cacheAdapter, mailerAdapter, loggerAdapter),
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	wait, err := h.throttle.Check(ctx, command.Email)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if wait > 0 {
+		return nil, exception.NewTooManyRequests().WithRetryAfter(wait).WithMessage(Err_TooManyAttempts)
+	}
+
+	account, err := h.accountRepository.GetByEmail(ctx, command.Email)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil {
+		return nil, h.reject(ctx, command.Email, nil)
+	}
+
+	credential, err := h.credentialRepository.GetByAccountID(ctx, account.ID, entity.AccountCredentialType_Password)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if credential == nil || !h.cryptoAdapter.VerifyPassword(command.Password, credential.PasswordHash) {
+		return nil, h.reject(ctx, command.Email, account)
+	}
+	if account.Status != entity.AccountStatus_Active {
+		return nil, exception.NewForbidden().WithMessage(Err_AccountNotActive)
+	}
+
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	// failures are only forgotten once the whole login succeeded, otherwise
+	// knowing the password would reset the budget for guessing the code
+	if mfa.Enabled() {
+		token, challenge, err := authn.OpenChallenge(ctx, h.cryptoAdapter, h.challengeRepository, account.ID)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		return &Result{MfaRequired: true, MfaToken: token, MfaExpiresAt: &challenge.ExpiresAt}, nil
+	}
+
+	required, err := authn.RequiredByTenant(ctx, h.membershipRepository, h.tenantConfigurationRepository, account.ID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	token, err := authn.IssueSession(ctx, h.jwtAdapter, h.cryptoAdapter, h.sessionRepository, account)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if err := h.throttle.Reset(ctx, command.Email); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Token: token, MfaEnrollmentRequired: required}, nil
+}
+
+func (h *Handler) reject(ctx context.Context, email string, account *entity.AccountEntity) error {
+	if err := h.throttle.Fail(ctx, email, account); err != nil {
+		return exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	return exception.NewUnauthorized().WithMessage(Err_InvalidCredentials)
+}
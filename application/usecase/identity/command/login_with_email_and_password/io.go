@@ -0,0 +1,32 @@
+package login_with_email_and_password
+
+import (
+	"time"
+
+	"src/application/adapter/jwt"
+	"src/core/validator"
+)
+
+type Command struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Email).Trim().Lowercase().Required().Email(),
+		validator.String(&c.Password).Required().Max(1024),
+	).Validate()
+}
+
+// Result carries either the session tokens or, when the account has MFA
+// enabled, the challenge to answer with complete_mfa_login.
+type Result struct {
+	Token                 *jwt.OpenIDToken `json:"token,omitempty"`
+	MfaRequired           bool             `json:"mfa_required"`
+	MfaToken              string           `json:"mfa_token,omitempty"`
+	MfaExpiresAt          *time.Time       `json:"mfa_expires_at,omitempty"`
+	MfaEnrollmentRequired bool             `json:"mfa_enrollment_required"`
+}
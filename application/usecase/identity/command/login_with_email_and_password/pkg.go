@@ -1,8 +1,35 @@
 package login_with_email_and_password
 
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	command := Command{Email: "jane.doe@example.com", Password: "correct horse battery staple"}
+	meta.Describe(&command,
+		meta.Description("Sign in with email and password. Accounts with MFA get a challenge to answer with complete_mfa_login instead of tokens. Repeated failures are slowed down per email and address, and lock the account for a while"),
+		meta.Example(&command),
+		meta.Field(&command.Email, meta.Description("Account email")),
+		meta.Field(&command.Password, meta.Description("Account password")),
+		meta.Throws[exception.Unauthorized](Err_InvalidCredentials),
+		meta.Throws[exception.Forbidden](Err_AccountNotActive),
+		meta.Throws[exception.TooManyRequests](Err_TooManyAttempts),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{MfaRequired: true, MfaToken: "q3H8v0b2Yx1kP9sLmR4tWc7zN5eA6dJf"}
+	meta.Describe(&result,
+		meta.Description("Session tokens, or the MFA challenge to answer"),
+		meta.Example(&result),
+		meta.Field(&result.Token, meta.Description("Access and refresh tokens, when no second factor is needed")),
+		meta.Field(&result.MfaRequired, meta.Description("The login must be completed with a TOTP or recovery code")),
+		meta.Field(&result.MfaToken, meta.Description("Challenge token for complete_mfa_login")),
+		meta.Field(&result.MfaExpiresAt, meta.Description("When the challenge expires")),
+		meta.Field(&result.MfaEnrollmentRequired, meta.Description("A tenant of the account requires MFA and the account has not enrolled yet; the session only reaches MFA enrollment until it does")))
+}
@@ -0,0 +1,83 @@
+package regenerate_recovery_codes
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/crypto"
+	"src/application/adapter/totp"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "regenerating recovery codes requires an authenticated account"
+	Err_NotEnabled       = "MFA is not enabled"
+	Err_InvalidCode      = "code is incorrect"
+	Err_Failed           = "recovery code regeneration failed"
+)
+
+type Handler struct {
+	cryptoAdapter          crypto.ICryptoAdapter
+	mfaRepository          repository.IAccountMfaRepository
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository
+	secondFactor           *authn.SecondFactor
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	totpAdapter totp.ITotpAdapter,
+	mfaRepository repository.IAccountMfaRepository,
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:          cryptoAdapter,
+		mfaRepository:          mfaRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		secondFactor:           authn.NewSecondFactor(totpAdapter, cryptoAdapter, mfaRepository, recoveryCodeRepository),
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !mfa.Enabled() {
+		return nil, exception.NewNotFound().WithMessage(Err_NotEnabled)
+	}
+
+	verified, err := h.secondFactor.Verify(ctx, mfa, command.Otp)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if !verified {
+		return nil, exception.NewUnprocessableEntity().WithMessage(Err_InvalidCode)
+	}
+
+	codes, entities, err := authn.NewRecoveryCodes(h.cryptoAdapter, *info.AccountID, time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if _, err := h.recoveryCodeRepository.DeleteByAccountID(ctx, *info.AccountID); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if err := h.recoveryCodeRepository.Insert(ctx, entities); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,30 @@
+package regenerate_recovery_codes
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Otp: "492039"}
+	meta.Describe(&command,
+		meta.Description("Replace the caller's recovery codes with a new set; the previous codes stop working"),
+		meta.Example(&command),
+		meta.Field(&command.Otp, meta.Description("Current TOTP code or an unused recovery code")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotEnabled),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidCode),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{RecoveryCodes: []string{"7KX2M-QH9TB", "C4NWE-8RZ3F"}}
+	meta.Describe(&result,
+		meta.Description("New single-use recovery codes"),
+		meta.Example(&result),
+		meta.Field(&result.RecoveryCodes, meta.Description("Each code can replace a TOTP code once")))
+}
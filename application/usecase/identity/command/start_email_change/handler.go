@@ -0,0 +1,114 @@
+package start_email_change
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/crypto"
+	"src/application/adapter/mailer"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "changing the email requires an authenticated account"
+	Err_FromAccessToken  = "an access token cannot change the account email"
+	Err_SameEmail        = "new email is the current email"
+	Err_EmailTaken       = "email is already used by another account"
+	Err_Failed           = "email change failed"
+)
+
+type Handler struct {
+	cryptoAdapter         crypto.ICryptoAdapter
+	mailerAdapter         mailer.IMailerAdapter
+	accountRepository     repository.IAccountRepository
+	emailChangeRepository repository.IAccountEmailChangeRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	mailerAdapter mailer.IMailerAdapter,
+	accountRepository repository.IAccountRepository,
+	emailChangeRepository repository.IAccountEmailChangeRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:         cryptoAdapter,
+		mailerAdapter:         mailerAdapter,
+		accountRepository:     accountRepository,
+		emailChangeRepository: emailChangeRepository,
+	}
+}
+
+// Handle opens an email change. Nothing changes until the new address
+// confirms it; a newer request cancels the open one.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+	if info.AccessTokenID != nil {
+		return nil, exception.NewForbidden().WithMessage(Err_FromAccessToken)
+	}
+
+	account, err := h.accountRepository.GetByID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+	if account.Email == command.NewEmail {
+		return nil, exception.NewConflict().WithMessage(Err_SameEmail)
+	}
+
+	taken, err := h.accountRepository.CountByEmail(ctx, command.NewEmail)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if taken > 0 {
+		return nil, exception.NewConflict().WithMessage(Err_EmailTaken)
+	}
+
+	confirmToken, err := authn.NewLinkToken()
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	cancelToken, err := authn.NewLinkToken()
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	now := time.Now().UTC()
+	change := &entity.AccountEmailChangeEntity{
+		ID:               uuid.New(),
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(authn.EmailChangeTTL),
+		ConfirmTokenHash: h.cryptoAdapter.Hash(confirmToken),
+		CancelTokenHash:  h.cryptoAdapter.Hash(cancelToken),
+		PreviousEmail:    account.Email,
+		NewEmail:         command.NewEmail,
+		AccountID:        account.ID,
+	}
+	if _, err := h.emailChangeRepository.CancelPending(ctx, account.ID, now); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if err := h.emailChangeRepository.Insert(ctx, change); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if err := authn.SendEmailChangeMails(ctx, h.mailerAdapter, change, confirmToken, cancelToken); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{ExpiresAt: change.ExpiresAt}, nil
+}
@@ -0,0 +1,28 @@
+package start_email_change
+
+import (
+	"time"
+
+	"src/core/cqrs"
+	"src/core/validator"
+)
+
+type Command struct {
+	NewEmail string `json:"new_email"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.NewEmail).Trim().Lowercase().Required().Email().Max(320),
+	).Validate()
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,33 @@
+package start_email_change
+
+import (
+	"time"
+
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{NewEmail: "jane.smith@example.com"}
+	meta.Describe(&command,
+		meta.Description("Ask to move the caller's account to a new email. The new address gets a confirmation link, the current one a notice with a link to cancel"),
+		meta.Example(&command),
+		meta.Field(&command.NewEmail, meta.Description("Email to move the account to")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_FromAccessToken),
+		meta.Throws[exception.Conflict](Err_SameEmail),
+		meta.Throws[exception.Conflict](Err_EmailTaken),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{ExpiresAt: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)}
+	meta.Describe(&result,
+		meta.Description("The change waiting for confirmation"),
+		meta.Example(&result),
+		meta.Field(&result.ExpiresAt, meta.Description("When the confirmation link expires")))
+}
@@ -0,0 +1,139 @@
+package start_impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/jwt"
+	"src/application/adapter/logger"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated      = "impersonating requires an authenticated account"
+	Err_FromAccessToken       = "an access token cannot impersonate"
+	Err_NotPlatformAdmin      = "only platform admins may impersonate"
+	Err_DestructiveNotAllowed = "only platform admins with the ADMIN role may allow destructive commands"
+	Err_SelfImpersonation     = "an account cannot impersonate itself"
+	Err_AccountNotFound       = "account not found"
+	Err_TargetIsPlatformAdmin = "platform admins cannot be impersonated"
+	Err_Failed                = "impersonation failed"
+)
+
+type Handler struct {
+	jwtAdapter              jwt.IJwtAdapter
+	loggerAdapter           logger.ILoggerAdapter
+	accountRepository       repository.IAccountRepository
+	platformAdminRepository repository.IPlatformAdminRepository
+	impersonationRepository repository.IImpersonationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	jwtAdapter jwt.IJwtAdapter,
+	loggerAdapter logger.ILoggerAdapter,
+	accountRepository repository.IAccountRepository,
+	platformAdminRepository repository.IPlatformAdminRepository,
+	impersonationRepository repository.IImpersonationRepository,
+) *Handler {
+	return &Handler{
+		jwtAdapter:              jwtAdapter,
+		loggerAdapter:           loggerAdapter,
+		accountRepository:       accountRepository,
+		platformAdminRepository: platformAdminRepository,
+		impersonationRepository: impersonationRepository,
+	}
+}
+
+// Handle issues a short-lived access token for another account. The token's
+// subject is that account and its "act" claim the platform admin, so every
+// request made with it is flagged as impersonated.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+	if info.AccessTokenID != nil {
+		return nil, exception.NewForbidden().WithMessage(Err_FromAccessToken)
+	}
+
+	admin, err := h.platformAdminRepository.GetActiveByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if admin == nil {
+		return nil, exception.NewForbidden().WithMessage(Err_NotPlatformAdmin)
+	}
+	if command.AllowDestructive && admin.Role != string(entity.PlatformAdminRole_Admin) {
+		return nil, exception.NewForbidden().WithMessage(Err_DestructiveNotAllowed)
+	}
+
+	accountID := uuid.MustParse(command.AccountID)
+	if accountID == *info.AccountID {
+		return nil, exception.NewConflict().WithMessage(Err_SelfImpersonation)
+	}
+	account, err := h.accountRepository.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil || account.Status == entity.AccountStatus_Deleted {
+		return nil, exception.NewNotFound().WithMessage(Err_AccountNotFound)
+	}
+	// acting as another admin would lend the caller that admin's rights
+	targetAdmin, err := h.platformAdminRepository.GetActiveByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if targetAdmin != nil {
+		return nil, exception.NewForbidden().WithMessage(Err_TargetIsPlatformAdmin)
+	}
+
+	now := time.Now().UTC()
+	impersonation := &entity.ImpersonationEntity{
+		ID:               uuid.New(),
+		CreatedAt:        now,
+		Reason:           command.Reason,
+		AllowDestructive: command.AllowDestructive,
+		IP:               info.IP,
+		UserAgent:        info.UserAgent,
+		ActorAccountID:   *info.AccountID,
+		AccountID:        account.ID,
+	}
+	token, err := h.jwtAdapter.Create(ctx, impersonation.ID.String(), &jwt.OpenIDInfo{
+		Subject: account.ID.String(),
+		Email:   account.Email,
+		Actor:   &jwt.Actor{Subject: info.AccountID.String()},
+	}, false)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	impersonation.ExpiresAt = now.Add(time.Duration(token.AccessExpiresIn) * time.Millisecond)
+
+	if err := h.impersonationRepository.Insert(ctx, impersonation); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	h.loggerAdapter.Warn("impersonation started", map[string]any{
+		"impersonation_id":        impersonation.ID.String(),
+		"account_id":              account.ID.String(),
+		"impersonator_account_id": info.AccountID.String(),
+		"allow_destructive":       impersonation.AllowDestructive,
+	})
+
+	return &Result{
+		ImpersonationID: impersonation.ID,
+		AccountID:       account.ID,
+		ExpiresAt:       impersonation.ExpiresAt,
+		Token:           token,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package start_impersonation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/jwt"
+	"src/core/validator"
+)
+
+type Command struct {
+	AccountID        string `json:"account_id"`
+	Reason           string `json:"reason"`
+	AllowDestructive bool   `json:"allow_destructive"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.AccountID).Trim().Required().GUID(),
+		validator.String(&c.Reason).Trim().Required().Min(10).Max(500),
+	).Validate()
+}
+
+type Result struct {
+	ImpersonationID uuid.UUID       `json:"impersonation_id"`
+	AccountID       uuid.UUID       `json:"account_id"`
+	ExpiresAt       time.Time       `json:"expires_at"`
+	Token           jwt.OpenIDToken `json:"token"`
+}
+
+func (r *Result) ActivityTarget() (string, string) {
+	return "account", r.AccountID.String()
+}
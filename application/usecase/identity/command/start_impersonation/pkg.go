@@ -0,0 +1,49 @@
+package start_impersonation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/jwt"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{AccountID: "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50", Reason: "Ticket #4821: customer cannot see their invoices"}
+	meta.Describe(&command,
+		meta.Description("Act as another account to see what its user sees. Platform admins only; the token is short-lived, has no refresh token and blocks destructive commands unless allowed"),
+		meta.Example(&command),
+		meta.Field(&command.AccountID, meta.Description("Account to impersonate")),
+		meta.Field(&command.Reason, meta.Description("Why the account is impersonated, kept in the audit trail")),
+		meta.Field(&command.AllowDestructive, meta.Description("Allow deleting, removing, revoking, cancelling and disabling while impersonating. ADMIN platform role only")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_FromAccessToken),
+		meta.Throws[exception.Forbidden](Err_NotPlatformAdmin),
+		meta.Throws[exception.Forbidden](Err_DestructiveNotAllowed),
+		meta.Throws[exception.Forbidden](Err_TargetIsPlatformAdmin),
+		meta.Throws[exception.Conflict](Err_SelfImpersonation),
+		meta.Throws[exception.NotFound](Err_AccountNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		ImpersonationID: uuid.MustParse("6f1c2d3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f"),
+		AccountID:       uuid.MustParse("0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"),
+		ExpiresAt:       time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC),
+		Token:           jwt.OpenIDToken{TokenType: "Bearer", AccessToken: "eyJhbGciOiJSUzI1NiIsInR5cCI6ImFjY2VzcyJ9...", AccessExpiresIn: 600000},
+	}
+	meta.Describe(&result,
+		meta.Description("The impersonation token"),
+		meta.Example(&result),
+		meta.Field(&result.ImpersonationID, meta.Description("Impersonation id, the token's session key")),
+		meta.Field(&result.AccountID, meta.Description("Impersonated account")),
+		meta.Field(&result.ExpiresAt, meta.Description("When the token expires")),
+		meta.Field(&result.Token, meta.Description("Access token acting as the account, with the caller in its \"act\" claim")))
+}
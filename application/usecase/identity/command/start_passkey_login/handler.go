@@ -0,0 +1,85 @@
+package start_passkey_login
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/webauthn"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_Failed = "passkey login failed"
+)
+
+type Handler struct {
+	cacheAdapter         cache.ICacheAdapter
+	webAuthnAdapter      webauthn.IWebAuthnAdapter
+	accountRepository    repository.IAccountRepository
+	credentialRepository repository.IAccountCredentialRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	webAuthnAdapter webauthn.IWebAuthnAdapter,
+	accountRepository repository.IAccountRepository,
+	credentialRepository repository.IAccountCredentialRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:         cacheAdapter,
+		webAuthnAdapter:      webAuthnAdapter,
+		accountRepository:    accountRepository,
+		credentialRepository: credentialRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	// an unknown email gets the same answer as no email, so the endpoint
+	// does not tell which accounts exist
+	allow := [][]byte{}
+	if command.Email != "" {
+		account, err := h.accountRepository.GetByEmail(ctx, command.Email)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if account != nil {
+			passkeys, err := h.credentialRepository.ListByAccountID(ctx, account.ID, entity.AccountCredentialType_Passkey)
+			if err != nil {
+				return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+			}
+			for _, passkey := range passkeys {
+				if id, err := base64.RawURLEncoding.DecodeString(passkey.ProviderSubject); err == nil {
+					allow = append(allow, id)
+				}
+			}
+		}
+	}
+
+	challenge, err := h.webAuthnAdapter.NewChallenge()
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	ceremonyID := uuid.New().String()
+	timeout := time.Duration(h.webAuthnAdapter.Config().Timeout) * time.Second
+	if err := h.cacheAdapter.Set(ctx, authn.PasskeyLoginKey(ceremonyID), challenge, timeout); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{
+		CeremonyID: ceremonyID,
+		Options:    h.webAuthnAdapter.RequestOptions(challenge, allow),
+	}, nil
+}
@@ -0,0 +1,23 @@
+package start_passkey_login
+
+import (
+	"src/application/adapter/webauthn"
+	"src/core/validator"
+)
+
+type Command struct {
+	Email string `json:"email"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.Email).Trim().Lowercase().Email(),
+	).Validate()
+}
+
+type Result struct {
+	CeremonyID string                   `json:"ceremony_id"`
+	Options    *webauthn.RequestOptions `json:"options"`
+}
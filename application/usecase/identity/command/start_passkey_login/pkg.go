@@ -0,0 +1,27 @@
+package start_passkey_login
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{Email: "jane.doe@example.com"}
+	meta.Describe(&command,
+		meta.Description("Open a passkey login. Pass the options to navigator.credentials.get() and send the response to finish_passkey_login with the ceremony id"),
+		meta.Example(&command),
+		meta.Field(&command.Email, meta.Description("Optional, narrows the prompt to the passkeys of this account. Without it the authenticator offers its discoverable passkeys")),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{CeremonyID: "6f1c2a9e-3d4b-4e5f-8a7b-9c0d1e2f3a4b"}
+	meta.Describe(&result,
+		meta.Description("Options for the browser WebAuthn API"),
+		meta.Field(&result.CeremonyID, meta.Description("Identifies the challenge, valid until the options time out")),
+		meta.Field(&result.Options, meta.Description("PublicKeyCredentialRequestOptions, binary values are base64url")))
+}
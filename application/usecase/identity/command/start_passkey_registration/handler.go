@@ -0,0 +1,92 @@
+package start_passkey_registration
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/webauthn"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "registering a passkey requires an authenticated account"
+	Err_Failed           = "passkey registration failed"
+)
+
+type Handler struct {
+	cacheAdapter         cache.ICacheAdapter
+	webAuthnAdapter      webauthn.IWebAuthnAdapter
+	accountRepository    repository.IAccountRepository
+	credentialRepository repository.IAccountCredentialRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	webAuthnAdapter webauthn.IWebAuthnAdapter,
+	accountRepository repository.IAccountRepository,
+	credentialRepository repository.IAccountCredentialRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:         cacheAdapter,
+		webAuthnAdapter:      webAuthnAdapter,
+		accountRepository:    accountRepository,
+		credentialRepository: credentialRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	account, err := h.accountRepository.GetByID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	passkeys, err := h.credentialRepository.ListByAccountID(ctx, account.ID, entity.AccountCredentialType_Passkey)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	exclude := make([][]byte, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		if id, err := base64.RawURLEncoding.DecodeString(passkey.ProviderSubject); err == nil {
+			exclude = append(exclude, id)
+		}
+	}
+
+	challenge, err := h.webAuthnAdapter.NewChallenge()
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	// a new registration replaces the one left open, if any
+	timeout := time.Duration(h.webAuthnAdapter.Config().Timeout) * time.Second
+	if err := h.cacheAdapter.Set(ctx, authn.PasskeyRegistrationKey(account.ID), challenge, timeout); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	// the user handle is the account id, so discoverable credentials lead back to it
+	return &Result{
+		Options: h.webAuthnAdapter.CreationOptions(challenge, webauthn.User{
+			ID:          base64.RawURLEncoding.EncodeToString(account.ID[:]),
+			Name:        account.Email,
+			DisplayName: account.Email,
+		}, exclude),
+	}, nil
+}
@@ -0,0 +1,9 @@
+package start_passkey_registration
+
+import "src/application/adapter/webauthn"
+
+type Command struct{}
+
+type Result struct {
+	Options *webauthn.CreationOptions `json:"options"`
+}
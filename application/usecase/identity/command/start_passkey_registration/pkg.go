@@ -0,0 +1,26 @@
+package start_passkey_registration
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{}
+	meta.Describe(&command,
+		meta.Description("Open a passkey registration for the caller. Pass the options to navigator.credentials.create() and send the response to finish_passkey_registration before they time out"),
+		meta.Example(&command),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{}
+	meta.Describe(&result,
+		meta.Description("Options for the browser WebAuthn API"),
+		meta.Field(&result.Options, meta.Description("PublicKeyCredentialCreationOptions, binary values are base64url")))
+}
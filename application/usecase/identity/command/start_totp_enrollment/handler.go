@@ -0,0 +1,97 @@
+package start_totp_enrollment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/crypto"
+	"src/application/adapter/totp"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "enrolling MFA requires an authenticated account"
+	Err_AlreadyEnabled   = "MFA is already enabled"
+	Err_Failed           = "MFA enrollment failed"
+)
+
+type Handler struct {
+	cryptoAdapter     crypto.ICryptoAdapter
+	totpAdapter       totp.ITotpAdapter
+	accountRepository repository.IAccountRepository
+	mfaRepository     repository.IAccountMfaRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	cryptoAdapter crypto.ICryptoAdapter,
+	totpAdapter totp.ITotpAdapter,
+	accountRepository repository.IAccountRepository,
+	mfaRepository repository.IAccountMfaRepository,
+) *Handler {
+	return &Handler{
+		cryptoAdapter:     cryptoAdapter,
+		totpAdapter:       totpAdapter,
+		accountRepository: accountRepository,
+		mfaRepository:     mfaRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	account, err := h.accountRepository.GetByID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if account == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	existing, err := h.mfaRepository.GetByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if existing.Enabled() {
+		return nil, exception.NewConflict().WithMessage(Err_AlreadyEnabled)
+	}
+	// restarting replaces a pending enrollment and its secret
+	if existing != nil {
+		if _, err := h.mfaRepository.DeleteByAccountID(ctx, account.ID); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+	}
+
+	secret, err := h.totpAdapter.GenerateSecret()
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	now := time.Now().UTC()
+	if err := h.mfaRepository.Insert(ctx, &entity.AccountMfaEntity{
+		ID:              uuid.New(),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		EncryptedSecret: h.cryptoAdapter.Encrypt(secret),
+		AccountID:       account.ID,
+	}); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{
+		Secret:     secret,
+		OtpauthURI: h.totpAdapter.URI(secret, account.Email),
+	}, nil
+}
@@ -0,0 +1,14 @@
+package start_totp_enrollment
+
+import "src/core/cqrs"
+
+type Command struct{}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+}
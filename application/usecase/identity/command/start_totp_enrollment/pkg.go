@@ -0,0 +1,32 @@
+package start_totp_enrollment
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{}
+	meta.Describe(&command,
+		meta.Description("Start TOTP enrollment for the caller. MFA stays off until confirm_totp_enrollment receives a valid code"),
+		meta.Example(&command),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Conflict](Err_AlreadyEnabled),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Secret:     "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP",
+		OtpauthURI: "otpauth://totp/Control%20Plane:jane.doe@example.com?algorithm=SHA1&digits=6&issuer=Control+Plane&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP",
+	}
+	meta.Describe(&result,
+		meta.Description("Shared secret to add to an authenticator app"),
+		meta.Example(&result),
+		meta.Field(&result.Secret, meta.Description("Base32 secret, for manual entry")),
+		meta.Field(&result.OtpauthURI, meta.Description("Key URI to render as a QR code")))
+}
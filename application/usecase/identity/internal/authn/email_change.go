@@ -0,0 +1,70 @@
+package authn
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"time"
+
+	"src/application/adapter/mailer"
+	"src/domain/entity"
+)
+
+// EmailChangeTTL is how long the links of an email change stay valid.
+const EmailChangeTTL = 24 * time.Hour
+
+// NewLinkToken returns a random token to embed in an emailed link; only its
+// hash is stored.
+func NewLinkToken() (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+// SendEmailChangeMails asks the new address to confirm the change and tells
+// the previous one, with a link to cancel it.
+func SendEmailChangeMails(
+	ctx context.Context,
+	mailerAdapter mailer.IMailerAdapter,
+	change *entity.AccountEmailChangeEntity,
+	confirmToken string,
+	cancelToken string,
+) error {
+	base := strings.TrimRight(mailerAdapter.Config().LinkBaseURL, "/")
+	confirmLink := base + "/account/email/confirm?token=" + url.QueryEscape(confirmToken)
+	cancelLink := base + "/account/email/cancel?token=" + url.QueryEscape(cancelToken)
+	expires := change.ExpiresAt.UTC().Format(time.RFC1123)
+
+	if err := mailerAdapter.Send(ctx, mailer.MailPayload{
+		To:      []string{change.NewEmail},
+		Subject: "Confirm your new email address",
+		Text: fmt.Sprintf(
+			"Open this link to use %s for your account:\n%s\n\nThe link expires on %s. If you did not ask for this, ignore this email.",
+			change.NewEmail, confirmLink, expires),
+		HTML: fmt.Sprintf(
+			"<p>Open this link to use %s for your account:</p><p><a href=\"%s\">Confirm my new email</a></p>"+
+				"<p>The link expires on %s. If you did not ask for this, ignore this email.</p>",
+			html.EscapeString(change.NewEmail), html.EscapeString(confirmLink), expires),
+	}); err != nil {
+		return err
+	}
+
+	return mailerAdapter.Send(ctx, mailer.MailPayload{
+		To:      []string{change.PreviousEmail},
+		Subject: "Your account email is about to change",
+		Text: fmt.Sprintf(
+			"Someone asked to move your account to %s. It changes once the new address is confirmed.\n"+
+				"If this was not you, cancel it now and change your password:\n%s",
+			change.NewEmail, cancelLink),
+		HTML: fmt.Sprintf(
+			"<p>Someone asked to move your account to %s. It changes once the new address is confirmed.</p>"+
+				"<p>If this was not you, cancel it now and change your password:</p><p><a href=\"%s\">Cancel the change</a></p>",
+			html.EscapeString(change.NewEmail), html.EscapeString(cancelLink)),
+	})
+}
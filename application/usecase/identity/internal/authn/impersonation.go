@@ -0,0 +1,81 @@
+package authn
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_CredentialWhileImpersonating  = "this command cannot run while impersonating"
+	Err_DestructiveWhileImpersonating = "destructive commands are blocked while impersonating"
+	Err_ImpersonationCheckFailed      = "impersonation check failed"
+)
+
+// destructivePrefixes name the use cases that destroy or undo data. They are
+// refused while impersonating unless the impersonation allows them.
+var destructivePrefixes = []string{"delete_", "remove_", "revoke_", "cancel_", "deactivate_", "disable_"}
+
+// credentialActions are never run while impersonating: they would leave the
+// admin a way into the account that outlives the impersonation.
+var credentialActions = []string{
+	"access_token.create_personal_access_token",
+	"access_token.create_tenant_api_key",
+	"identity.confirm_totp_enrollment",
+	"identity.finish_passkey_registration",
+	"identity.regenerate_recovery_codes",
+	"identity.start_email_change",
+	"identity.start_impersonation",
+	"identity.start_passkey_registration",
+	"identity.start_totp_enrollment",
+}
+
+// GuardImpersonation is the command guard that limits what a platform admin
+// may do while impersonating an account.
+func GuardImpersonation(ctx context.Context, command cqrs.Command) error {
+	info := common.GetRequestInfo(ctx)
+	if info.ImpersonatorAccountID == nil {
+		return nil
+	}
+
+	action := cqrs.UseCaseName(command)
+	if slices.Contains(credentialActions, action) {
+		return exception.NewForbidden().WithMessage(Err_CredentialWhileImpersonating)
+	}
+	_, name, _ := strings.Cut(action, ".")
+	if !slices.ContainsFunc(destructivePrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	}) {
+		return nil
+	}
+
+	allowed, err := allowsDestructive(ctx, info.SessionKey)
+	if err != nil {
+		return exception.NewInternal().WithCause(err).WithMessage(Err_ImpersonationCheckFailed)
+	}
+	if !allowed {
+		return exception.NewForbidden().WithMessage(Err_DestructiveWhileImpersonating)
+	}
+	return nil
+}
+
+func allowsDestructive(ctx context.Context, sessionKey string) (bool, error) {
+	id, err := uuid.Parse(sessionKey)
+	if err != nil {
+		return false, nil
+	}
+	impersonation, err := di.Resolve[repository.IImpersonationRepository]().GetByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return impersonation.Active(time.Now().UTC()) && impersonation.AllowDestructive, nil
+}
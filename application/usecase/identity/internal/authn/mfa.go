@@ -0,0 +1,185 @@
+package authn
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/crypto"
+	"src/application/adapter/totp"
+	"src/core/common"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+const (
+	RecoveryCodeCount = 10
+
+	// a login challenge must be answered within ChallengeTTL and allows
+	// ChallengeMaxAttempts wrong codes
+	ChallengeTTL         = 5 * time.Minute
+	ChallengeMaxAttempts = 5
+
+	// recoveryCodeAlphabet leaves out 0, 1, I and O, which are easy to misread.
+	recoveryCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	recoveryCodeLength   = 10
+)
+
+// SecondFactor checks the second step of a login or of a sensitive MFA
+// change: a TOTP code or, failing that, an unused recovery code.
+type SecondFactor struct {
+	totpAdapter            totp.ITotpAdapter
+	cryptoAdapter          crypto.ICryptoAdapter
+	mfaRepository          repository.IAccountMfaRepository
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository
+}
+
+func NewSecondFactor(
+	totpAdapter totp.ITotpAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	mfaRepository repository.IAccountMfaRepository,
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository,
+) *SecondFactor {
+	return &SecondFactor{
+		totpAdapter:            totpAdapter,
+		cryptoAdapter:          cryptoAdapter,
+		mfaRepository:          mfaRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+	}
+}
+
+// Verify spends code against a confirmed enrollment. A TOTP code is accepted
+// once per time step and a recovery code only once.
+func (f *SecondFactor) Verify(ctx context.Context, mfa *entity.AccountMfaEntity, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if !mfa.Enabled() || code == "" {
+		return false, nil
+	}
+
+	if isDigits(code) {
+		secret, err := f.Secret(mfa)
+		if err != nil {
+			return false, err
+		}
+		step, ok := f.totpAdapter.Validate(secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+		updated, err := f.mfaRepository.UseStep(ctx, mfa.AccountID, step, time.Now())
+		return updated > 0, err
+	}
+
+	used, err := f.recoveryCodeRepository.Use(ctx, mfa.AccountID, HashRecoveryCode(f.cryptoAdapter, code), time.Now())
+	return used > 0, err
+}
+
+// Secret decrypts the shared TOTP secret of an enrollment.
+func (f *SecondFactor) Secret(mfa *entity.AccountMfaEntity) (string, error) {
+	decrypted, err := f.cryptoAdapter.Decrypt(mfa.EncryptedSecret)
+	if err != nil {
+		return "", err
+	}
+	secret, ok := decrypted.(string)
+	if !ok {
+		return "", errors.New("totp secret is not a string")
+	}
+	return secret, nil
+}
+
+// NewRecoveryCodes generates a fresh set of codes, returning them in clear
+// for the account owner and hashed for storage.
+func NewRecoveryCodes(cryptoAdapter crypto.ICryptoAdapter, accountID uuid.UUID, at time.Time) ([]string, []entity.AccountRecoveryCodeEntity, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	entities := make([]entity.AccountRecoveryCodeEntity, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		random := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(random); err != nil {
+			return nil, nil, err
+		}
+		for index, value := range random {
+			random[index] = recoveryCodeAlphabet[int(value)%len(recoveryCodeAlphabet)]
+		}
+		code := string(random[:recoveryCodeLength/2]) + "-" + string(random[recoveryCodeLength/2:])
+
+		codes = append(codes, code)
+		entities = append(entities, entity.AccountRecoveryCodeEntity{
+			ID:        uuid.New(),
+			CreatedAt: at.UTC(),
+			CodeHash:  HashRecoveryCode(cryptoAdapter, code),
+			AccountID: accountID,
+		})
+	}
+	return codes, entities, nil
+}
+
+// HashRecoveryCode ignores case, spaces and dashes, so codes can be typed
+// back however they were written down.
+func HashRecoveryCode(cryptoAdapter crypto.ICryptoAdapter, code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToUpper(code))
+	return cryptoAdapter.Hash(normalized)
+}
+
+// RequiredByTenant reports whether a tenant the account is an active member
+// of requires MFA.
+func RequiredByTenant(
+	ctx context.Context,
+	membershipRepository repository.IMembershipRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+	accountID uuid.UUID,
+) (bool, error) {
+	memberships, err := membershipRepository.ListActiveByAccountID(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	tenantIDs := make([]uuid.UUID, 0, len(memberships))
+	for _, membership := range memberships {
+		tenantIDs = append(tenantIDs, membership.TenantID)
+	}
+	count, err := tenantConfigurationRepository.CountRequiringMfa(ctx, tenantIDs)
+	return count > 0, err
+}
+
+// OpenChallenge defers the session of a first factor until complete_mfa_login
+// receives a second factor for the returned token.
+func OpenChallenge(
+	ctx context.Context,
+	cryptoAdapter crypto.ICryptoAdapter,
+	challengeRepository repository.IAccountMfaChallengeRepository,
+	accountID uuid.UUID,
+) (string, *entity.AccountMfaChallengeEntity, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(random)
+
+	info := common.GetRequestInfo(ctx)
+	now := time.Now().UTC()
+	challenge := &entity.AccountMfaChallengeEntity{
+		ID:        uuid.New(),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ChallengeTTL),
+		TokenHash: cryptoAdapter.Hash(token),
+		IP:        info.IP,
+		UserAgent: info.UserAgent,
+		AccountID: accountID,
+	}
+	if err := challengeRepository.Insert(ctx, challenge); err != nil {
+		return "", nil, err
+	}
+	return token, challenge, nil
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package authn
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/cache"
+	"src/domain/entity"
+)
+
+// Passkey is the public view of a passkey credential.
+type Passkey struct {
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastUsedAt     *time.Time `json:"last_used_at"`
+	Name           string     `json:"name"`
+	Aaguid         string     `json:"aaguid"`
+	BackupEligible bool       `json:"backup_eligible"`
+}
+
+func NewPasskey(credential *entity.AccountCredentialEntity) Passkey {
+	return Passkey{
+		ID:             credential.ID,
+		CreatedAt:      credential.CreatedAt,
+		LastUsedAt:     credential.LastUsedAt,
+		Name:           credential.Name,
+		Aaguid:         credential.Aaguid,
+		BackupEligible: credential.BackupEligible,
+	}
+}
+
+// PasskeyRegistrationKey and PasskeyLoginKey name the cache entries holding
+// the challenge of an open ceremony.
+func PasskeyRegistrationKey(accountID uuid.UUID) string {
+	return "webauthn:registration:" + accountID.String()
+}
+
+func PasskeyLoginKey(ceremonyID string) string {
+	return "webauthn:login:" + ceremonyID
+}
+
+// TakeChallenge reads a ceremony challenge and removes it, so a response can
+// only be checked once against it.
+func TakeChallenge(ctx context.Context, cacheAdapter cache.ICacheAdapter, key string) (string, bool, error) {
+	challenge, found, err := cacheAdapter.Get(ctx, key)
+	if err != nil || !found {
+		return "", false, err
+	}
+	if err := cacheAdapter.Delete(ctx, key); err != nil {
+		return "", false, err
+	}
+	return challenge, true, nil
+}
@@ -0,0 +1,122 @@
+package authn
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/crypto"
+	"src/application/adapter/jwt"
+	"src/core/common"
+	"src/domain/entity"
+	"src/domain/event"
+	"src/domain/repository"
+)
+
+// revokedSessionTTL bounds how long a revocation marker is kept for a session
+// without an expiry.
+const revokedSessionTTL = 30 * 24 * time.Hour
+
+// IssueSession opens a session for an authenticated account and returns its
+// tokens. The session id is the token's session key.
+func IssueSession(
+	ctx context.Context,
+	jwtAdapter jwt.IJwtAdapter,
+	cryptoAdapter crypto.ICryptoAdapter,
+	sessionRepository repository.IAccountSessionRepository,
+	account *entity.AccountEntity,
+) (*jwt.OpenIDToken, error) {
+	info := common.GetRequestInfo(ctx)
+	now := time.Now().UTC()
+	session := &entity.AccountSessionEntity{
+		ID:        uuid.New(),
+		CreatedAt: now,
+		UserAgent: info.UserAgent,
+		IP:        info.IP,
+		AccountID: account.ID,
+	}
+
+	token, err := jwtAdapter.Create(ctx, session.ID.String(), &jwt.OpenIDInfo{
+		Subject: account.ID.String(),
+		Email:   account.Email,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken != nil {
+		session.RefreshTokenHash = cryptoAdapter.Hash(*token.RefreshToken)
+	}
+	if token.RefreshExpiresIn != nil {
+		expiresAt := now.Add(time.Duration(*token.RefreshExpiresIn) * time.Millisecond)
+		session.ExpiresAt = &expiresAt
+	}
+
+	if err := sessionRepository.Insert(ctx, session); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RevokedSessionKey names the cache marker that makes the auth guard refuse
+// the access tokens of a revoked session.
+func RevokedSessionKey(sessionKey string) string {
+	return "session:revoked:" + sessionKey
+}
+
+// RevokeOtherSessions revokes every active session of an account except
+// keep (the caller's session key, may be empty). Call MarkSessionsRevoked
+// with the result once the transaction committed.
+func RevokeOtherSessions(
+	ctx context.Context,
+	sessionRepository repository.IAccountSessionRepository,
+	accountID uuid.UUID,
+	keep string,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.AccountSessionEntity, error) {
+	sessions, err := sessionRepository.ListActiveByAccountID(ctx, accountID, optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make([]entity.AccountSessionEntity, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID.String() == keep {
+			continue
+		}
+		affected, err := sessionRepository.Revoke(ctx, session.ID, at, optionalUow...)
+		if err != nil {
+			return nil, err
+		}
+		if affected > 0 {
+			revoked = append(revoked, session)
+		}
+	}
+	return revoked, nil
+}
+
+// MarkSessionsRevoked records the revocation in the cache until each session
+// would have expired, and returns the events that close their live streams.
+func MarkSessionsRevoked(
+	ctx context.Context,
+	cacheAdapter cache.ICacheAdapter,
+	sessions []entity.AccountSessionEntity,
+	at time.Time,
+) ([]event.IDomainEvent, error) {
+	events := make([]event.IDomainEvent, 0, len(sessions))
+	for _, session := range sessions {
+		ttl := revokedSessionTTL
+		if session.ExpiresAt != nil {
+			ttl = session.ExpiresAt.Sub(at)
+		}
+		if ttl > 0 {
+			if err := cacheAdapter.Set(ctx, RevokedSessionKey(session.ID.String()), at.UTC().Format(time.RFC3339), ttl); err != nil {
+				return nil, err
+			}
+		}
+		events = append(events, event.AccountSessionRevoked{SessionID: session.ID, AccountID: session.AccountID})
+	}
+	return events, nil
+}
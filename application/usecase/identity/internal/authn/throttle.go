@@ -0,0 +1,203 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"src/application/adapter/cache"
+	"src/application/adapter/logger"
+	"src/application/adapter/mailer"
+	"src/core/common"
+	"src/domain/entity"
+)
+
+// ThrottlePolicy describes how failed attempts against one key are slowed
+// down: the first FreeAttempts failures cost nothing, every further one
+// doubles the wait from BaseDelay up to MaxDelay. Reaching LockoutAfter
+// failures blocks the key for LockoutDuration; a zero LockoutAfter never
+// locks. Failures are forgotten Window after the last one.
+type ThrottlePolicy struct {
+	FreeAttempts    int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutAfter    int
+	LockoutDuration time.Duration
+	Window          time.Duration
+}
+
+var (
+	// AccountThrottlePolicy applies per email, so guessing one account's
+	// password or second factor is slowed down whatever the source address.
+	AccountThrottlePolicy = ThrottlePolicy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        5 * time.Minute,
+		LockoutAfter:    10,
+		LockoutDuration: 30 * time.Minute,
+		Window:          time.Hour,
+	}
+	// IPThrottlePolicy applies per client address, so spraying many accounts
+	// from one source is slowed down too. Addresses are never locked out.
+	IPThrottlePolicy = ThrottlePolicy{
+		FreeAttempts: 20,
+		BaseDelay:    time.Second,
+		MaxDelay:     15 * time.Minute,
+		Window:       time.Hour,
+	}
+)
+
+// Throttle limits failed login attempts (passwords and one-time codes) per
+// account and per client address on top of the cache. Failures are counted
+// with the cache's atomic increment, so concurrent attempts each get their
+// own count and exactly one of them reaches the lockout. The resulting wait
+// is kept under a separate key until it ends.
+type Throttle struct {
+	cacheAdapter  cache.ICacheAdapter
+	mailerAdapter mailer.IMailerAdapter
+	loggerAdapter logger.ILoggerAdapter
+}
+
+func NewThrottle(
+	cacheAdapter cache.ICacheAdapter,
+	mailerAdapter mailer.IMailerAdapter,
+	loggerAdapter logger.ILoggerAdapter,
+) *Throttle {
+	return &Throttle{
+		cacheAdapter:  cacheAdapter,
+		mailerAdapter: mailerAdapter,
+		loggerAdapter: loggerAdapter,
+	}
+}
+
+// Check returns how long the caller must wait before trying email again from
+// its address, zero when it may try now.
+func (t *Throttle) Check(ctx context.Context, email string) (time.Duration, error) {
+	now := time.Now()
+	var wait time.Duration
+	for _, key := range t.keys(ctx, email) {
+		if key == "" {
+			continue
+		}
+		value, found, err := t.cacheAdapter.Get(ctx, blockedKey(key))
+		if err != nil {
+			return 0, err
+		}
+		// a corrupt entry is treated as no block rather than locking the key
+		until, err := time.Parse(time.RFC3339Nano, value)
+		if !found || err != nil {
+			continue
+		}
+		if remaining := until.Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+	return wait, nil
+}
+
+// Fail records a failed attempt against email. When it locks the account out,
+// account (nil when the email is unknown) is told by email.
+func (t *Throttle) Fail(ctx context.Context, email string, account *entity.AccountEntity) error {
+	keys := t.keys(ctx, email)
+	policies := []ThrottlePolicy{AccountThrottlePolicy, IPThrottlePolicy}
+	now := time.Now()
+
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		policy := policies[i]
+		failures, err := t.cacheAdapter.Increment(ctx, key, max(policy.Window, policy.LockoutDuration))
+		if err != nil {
+			return err
+		}
+
+		var wait time.Duration
+		switch {
+		case policy.LockoutAfter > 0 && failures >= int64(policy.LockoutAfter):
+			wait = policy.LockoutDuration
+		case failures > int64(policy.FreeAttempts):
+			wait = policy.delay(int(failures) - policy.FreeAttempts)
+		default:
+			continue
+		}
+
+		until := now.Add(wait)
+		if err := t.cacheAdapter.Set(ctx, blockedKey(key), until.Format(time.RFC3339Nano), wait); err != nil {
+			return err
+		}
+		if policy.LockoutAfter > 0 && failures == int64(policy.LockoutAfter) && account != nil {
+			t.notifyLockout(ctx, account, until)
+		}
+	}
+	return nil
+}
+
+// Reset forgets the failures against email after a successful login. The
+// address counter is kept, it only decays with its window.
+func (t *Throttle) Reset(ctx context.Context, email string) error {
+	key := accountThrottleKey(email)
+	if err := t.cacheAdapter.Delete(ctx, key); err != nil {
+		return err
+	}
+	return t.cacheAdapter.Delete(ctx, blockedKey(key))
+}
+
+func (p ThrottlePolicy) delay(excess int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < excess && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+func (t *Throttle) keys(ctx context.Context, email string) []string {
+	keys := []string{accountThrottleKey(email), ""}
+	if ip := common.GetRequestInfo(ctx).IP; ip != "" {
+		keys[1] = "throttle:login:ip:" + ip
+	}
+	return keys
+}
+
+func accountThrottleKey(email string) string {
+	return "throttle:login:account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// blockedKey names the entry holding until when a throttle key is blocked.
+func blockedKey(key string) string {
+	return key + ":blocked"
+}
+
+// notifyLockout warns the owner that sign-in is blocked. A failed delivery is
+// logged and does not change the outcome of the login attempt.
+func (t *Throttle) notifyLockout(ctx context.Context, account *entity.AccountEntity, until time.Time) {
+	info := common.GetRequestInfo(ctx)
+	until = until.UTC()
+	err := t.mailerAdapter.Send(ctx, mailer.MailPayload{
+		To:      []string{account.Email},
+		Subject: "Sign-in to your account is temporarily locked",
+		Text: fmt.Sprintf(
+			"We blocked sign-in to your account after too many failed attempts (last one from %s).\n"+
+				"You can sign in again after %s. If this was not you, reset your password once the lock expires.",
+			ipOrUnknown(info.IP), until.Format(time.RFC1123)),
+		HTML: fmt.Sprintf(
+			"<p>We blocked sign-in to your account after too many failed attempts (last one from %s).</p>"+
+				"<p>You can sign in again after %s. If this was not you, reset your password once the lock expires.</p>",
+			html.EscapeString(ipOrUnknown(info.IP)), until.Format(time.RFC1123)),
+	})
+	if err != nil {
+		t.loggerAdapter.Error("lockout email not sent", map[string]any{
+			"account_id": account.ID.String(),
+			"err":        err.Error(),
+		})
+	}
+}
+
+func ipOrUnknown(ip string) string {
+	if ip == "" {
+		return "an unknown address"
+	}
+	return ip
+}
@@ -2,33 +2,73 @@ package identity
 
 import (
 	"src/application/usecase/identity/command/activate_email"
+	"src/application/usecase/identity/command/cancel_email_change"
+	"src/application/usecase/identity/command/complete_mfa_login"
 	"src/application/usecase/identity/command/complete_sso_callback"
+	"src/application/usecase/identity/command/confirm_email_change"
+	"src/application/usecase/identity/command/confirm_totp_enrollment"
 	"src/application/usecase/identity/command/delete_account"
+	"src/application/usecase/identity/command/delete_passkey"
+	"src/application/usecase/identity/command/disable_mfa"
+	"src/application/usecase/identity/command/end_impersonation"
+	"src/application/usecase/identity/command/finish_passkey_login"
+	"src/application/usecase/identity/command/finish_passkey_registration"
 	"src/application/usecase/identity/command/login_with_email_and_password"
 	"src/application/usecase/identity/command/login_with_email_otp"
 	"src/application/usecase/identity/command/login_with_sso_token"
+	"src/application/usecase/identity/command/regenerate_recovery_codes"
 	"src/application/usecase/identity/command/register_account_with_email"
 	"src/application/usecase/identity/command/resend_activation_email"
 	"src/application/usecase/identity/command/reset_password"
+	"src/application/usecase/identity/command/start_email_change"
+	"src/application/usecase/identity/command/start_impersonation"
+	"src/application/usecase/identity/command/start_passkey_login"
+	"src/application/usecase/identity/command/start_passkey_registration"
 	"src/application/usecase/identity/command/start_password_recovery"
 	"src/application/usecase/identity/command/start_sso_login"
+	"src/application/usecase/identity/command/start_totp_enrollment"
+	"src/application/usecase/identity/internal/authn"
+	"src/application/usecase/identity/query/authenticate_session"
 	"src/application/usecase/identity/query/check_email_availability"
 	"src/application/usecase/identity/query/get_account_by_id"
+	"src/application/usecase/identity/query/get_mfa_status"
+	"src/application/usecase/identity/query/list_passkey"
+	"src/core/cqrs"
 )
 
 func Register() {
+	cqrs.RegisterCommandGuard(authn.GuardImpersonation)
+
 	activate_email.Register()
+	cancel_email_change.Register()
+	complete_mfa_login.Register()
 	complete_sso_callback.Register()
+	confirm_email_change.Register()
+	confirm_totp_enrollment.Register()
 	delete_account.Register()
+	delete_passkey.Register()
+	disable_mfa.Register()
+	end_impersonation.Register()
+	finish_passkey_login.Register()
+	finish_passkey_registration.Register()
 	login_with_email_and_password.Register()
 	login_with_email_otp.Register()
 	login_with_sso_token.Register()
+	regenerate_recovery_codes.Register()
 	register_account_with_email.Register()
 	resend_activation_email.Register()
 	reset_password.Register()
+	start_email_change.Register()
+	start_impersonation.Register()
+	start_passkey_login.Register()
+	start_passkey_registration.Register()
 	start_password_recovery.Register()
 	start_sso_login.Register()
+	start_totp_enrollment.Register()
 
+	authenticate_session.Register()
 	check_email_availability.Register()
 	get_account_by_id.Register()
+	get_mfa_status.Register()
+	list_passkey.Register()
 }
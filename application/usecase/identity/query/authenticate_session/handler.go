@@ -0,0 +1,74 @@
+package authenticate_session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/cache"
+	"src/application/usecase/identity/internal/authn"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_SessionRevoked = "session was revoked"
+	Err_Failed         = "session check failed"
+)
+
+type Handler struct {
+	cacheAdapter                  cache.ICacheAdapter
+	mfaRepository                 repository.IAccountMfaRepository
+	membershipRepository          repository.IMembershipRepository
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	cacheAdapter cache.ICacheAdapter,
+	mfaRepository repository.IAccountMfaRepository,
+	membershipRepository repository.IMembershipRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+) *Handler {
+	return &Handler{
+		cacheAdapter:                  cacheAdapter,
+		mfaRepository:                 mfaRepository,
+		membershipRepository:          membershipRepository,
+		tenantConfigurationRepository: tenantConfigurationRepository,
+	}
+}
+
+// Handle refuses a session revoked before its access tokens expired; the
+// token itself was already verified by the caller. It also tells whether
+// the account still has to enroll MFA for one of its tenants, which is
+// checked on every request so enrolling lifts the restriction at once and
+// a tenant turning the requirement on applies to open sessions.
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	revoked, err := h.cacheAdapter.Has(ctx, authn.RevokedSessionKey(query.SessionKey))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if revoked {
+		return nil, exception.NewUnauthorized().WithMessage(Err_SessionRevoked)
+	}
+
+	accountID := uuid.MustParse(query.AccountID)
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if mfa.Enabled() {
+		return &Result{}, nil
+	}
+	required, err := authn.RequiredByTenant(ctx, h.membershipRepository, h.tenantConfigurationRepository, accountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{MfaEnrollmentRequired: required}, nil
+}
@@ -0,0 +1,21 @@
+package authenticate_session
+
+import "src/core/validator"
+
+type Query struct {
+	SessionKey string `json:"session_key"`
+	AccountID  string `json:"account_id"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.SessionKey).Trim().Required().Max(128),
+		validator.String(&q.AccountID).Trim().Lowercase().Required().GUID(),
+	).Validate()
+}
+
+type Result struct {
+	MfaEnrollmentRequired bool `json:"mfa_enrollment_required"`
+}
@@ -0,0 +1,32 @@
+package authenticate_session
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{
+		SessionKey: "6f1c2d3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+		AccountID:  "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50",
+	}
+	meta.Describe(&query,
+		meta.Description("Check that the session of a verified access token was not revoked"),
+		meta.Example(&query),
+		meta.Field(&query.SessionKey, meta.Description("Session key (jti) of the token")),
+		meta.Field(&query.AccountID, meta.Description("Account the token was issued to (sub)")),
+		meta.Throws[exception.Unauthorized](Err_SessionRevoked),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{MfaEnrollmentRequired: true}
+	meta.Describe(&result,
+		meta.Description("State of a valid session"),
+		meta.Example(&result),
+		meta.Field(&result.MfaEnrollmentRequired, meta.Description("A tenant of the account requires MFA and the account has not enrolled yet, so the session is limited to MFA enrollment")))
+}
@@ -0,0 +1,73 @@
+package get_mfa_status
+
+import (
+	"context"
+
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "reading the MFA status requires an authenticated account"
+	Err_Failed           = "reading the MFA status failed"
+)
+
+type Handler struct {
+	mfaRepository                 repository.IAccountMfaRepository
+	recoveryCodeRepository        repository.IAccountRecoveryCodeRepository
+	membershipRepository          repository.IMembershipRepository
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	mfaRepository repository.IAccountMfaRepository,
+	recoveryCodeRepository repository.IAccountRecoveryCodeRepository,
+	membershipRepository repository.IMembershipRepository,
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+) *Handler {
+	return &Handler{
+		mfaRepository:                 mfaRepository,
+		recoveryCodeRepository:        recoveryCodeRepository,
+		membershipRepository:          membershipRepository,
+		tenantConfigurationRepository: tenantConfigurationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	mfa, err := h.mfaRepository.GetByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	required, err := authn.RequiredByTenant(ctx, h.membershipRepository, h.tenantConfigurationRepository, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	result := &Result{RequiredByTenant: required}
+	if mfa == nil {
+		return result, nil
+	}
+	result.Enabled = mfa.Enabled()
+	result.Pending = !mfa.Enabled()
+	result.ConfirmedAt = mfa.ConfirmedAt
+	if result.Enabled {
+		if result.RemainingRecoveryCodes, err = h.recoveryCodeRepository.CountUnused(ctx, *info.AccountID); err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,13 @@
+package get_mfa_status
+
+import "time"
+
+type Query struct{}
+
+type Result struct {
+	Enabled                bool       `json:"enabled"`
+	Pending                bool       `json:"pending"`
+	ConfirmedAt            *time.Time `json:"confirmed_at"`
+	RemainingRecoveryCodes int64      `json:"remaining_recovery_codes"`
+	RequiredByTenant       bool       `json:"required_by_tenant"`
+}
@@ -0,0 +1,38 @@
+package get_mfa_status
+
+import (
+	"time"
+
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{}
+	meta.Describe(&query,
+		meta.Description("Describe the caller's MFA enrollment"),
+		meta.Example(&query),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{
+		Enabled:                true,
+		ConfirmedAt:            core.Ptr(time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)),
+		RemainingRecoveryCodes: 8,
+	}
+	meta.Describe(&result,
+		meta.Description("MFA enrollment of the caller"),
+		meta.Example(&result),
+		meta.Field(&result.Enabled, meta.Description("Logins require a second factor")),
+		meta.Field(&result.Pending, meta.Description("Enrollment was started but not confirmed")),
+		meta.Field(&result.ConfirmedAt, meta.Description("When MFA was enabled")),
+		meta.Field(&result.RemainingRecoveryCodes, meta.Description("Unused recovery codes")),
+		meta.Field(&result.RequiredByTenant, meta.Description("A tenant of the account requires MFA")))
+}
@@ -0,0 +1,52 @@
+package list_passkey
+
+import (
+	"context"
+
+	"src/application/usecase/identity/internal/authn"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "listing passkeys requires an authenticated account"
+	Err_Failed           = "listing passkeys failed"
+)
+
+type Handler struct {
+	credentialRepository repository.IAccountCredentialRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	credentialRepository repository.IAccountCredentialRepository,
+) *Handler {
+	return &Handler{
+		credentialRepository: credentialRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	credentials, err := h.credentialRepository.ListByAccountID(ctx, *info.AccountID, entity.AccountCredentialType_Passkey)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	items := make([]authn.Passkey, 0, len(credentials))
+	for i := range credentials {
+		items = append(items, authn.NewPasskey(&credentials[i]))
+	}
+	return &Result{Items: items}, nil
+}
@@ -0,0 +1,9 @@
+package list_passkey
+
+import "src/application/usecase/identity/internal/authn"
+
+type Query struct{}
+
+type Result struct {
+	Items []authn.Passkey `json:"items"`
+}
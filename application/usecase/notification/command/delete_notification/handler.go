@@ -0,0 +1,54 @@
+package delete_notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated     = "deleting a notification requires an authenticated account"
+	Err_NotificationNotFound = "notification not found"
+	Err_Failed               = "notification could not be deleted"
+)
+
+type Handler struct {
+	notificationRepository repository.INotificationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	notificationRepository repository.INotificationRepository,
+) *Handler {
+	return &Handler{
+		notificationRepository: notificationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	deleted, err := h.notificationRepository.Delete(ctx,
+		*info.AccountID,
+		uuid.MustParse(command.NotificationID))
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if deleted == 0 {
+		return nil, exception.NewNotFound().WithMessage(Err_NotificationNotFound)
+	}
+
+	return &Result{}, nil
+}
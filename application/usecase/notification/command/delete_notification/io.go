@@ -0,0 +1,17 @@
+package delete_notification
+
+import "src/core/validator"
+
+type Command struct {
+	NotificationID string `json:"notification_id"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.NotificationID).Required().GUID(),
+	).Validate()
+}
+
+type Result struct{}
@@ -0,0 +1,23 @@
+package delete_notification
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{NotificationID: "5d2c4f0e-8a41-4b7e-9c1a-3f6e2b8d7a90"}
+	meta.Describe(&command,
+		meta.Description("Delete one of the caller's notifications"),
+		meta.Example(&command),
+		meta.Field(&command.NotificationID, meta.Description("Notification to delete")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotificationNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -0,0 +1,55 @@
+package mark_all_notification_read
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "marking notifications read requires an authenticated account"
+	Err_Failed           = "notifications could not be marked read"
+)
+
+type Handler struct {
+	notificationRepository repository.INotificationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	notificationRepository repository.INotificationRepository,
+) *Handler {
+	return &Handler{
+		notificationRepository: notificationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	var tenantID *uuid.UUID
+	if command.TenantID != "" {
+		tenantID = core.Ptr(uuid.MustParse(command.TenantID))
+	}
+
+	updated, err := h.notificationRepository.MarkAllRead(ctx, *info.AccountID, tenantID, time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Updated: updated}, nil
+}
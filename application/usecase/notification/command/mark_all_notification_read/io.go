@@ -0,0 +1,19 @@
+package mark_all_notification_read
+
+import "src/core/validator"
+
+type Command struct {
+	TenantID string `json:"tenant_id"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.TenantID).GUID(),
+	).Validate()
+}
+
+type Result struct {
+	Updated int64 `json:"updated"`
+}
@@ -0,0 +1,28 @@
+package mark_all_notification_read
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{TenantID: "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"}
+	meta.Describe(&command,
+		meta.Description("Mark every unread notification of the caller as read"),
+		meta.Example(&command),
+		meta.Field(&command.TenantID, meta.Description("Only notifications of this tenant; all of them when empty")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Updated: 3}
+	meta.Describe(&result,
+		meta.Description("Notifications marked as read"),
+		meta.Example(&result),
+		meta.Field(&result.Updated, meta.Description("Number of notifications that were unread")))
+}
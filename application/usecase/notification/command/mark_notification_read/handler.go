@@ -0,0 +1,56 @@
+package mark_notification_read
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated     = "marking a notification read requires an authenticated account"
+	Err_NotificationNotFound = "notification not found"
+	Err_Failed               = "notification could not be marked read"
+)
+
+type Handler struct {
+	notificationRepository repository.INotificationRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	notificationRepository repository.INotificationRepository,
+) *Handler {
+	return &Handler{
+		notificationRepository: notificationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	updated, err := h.notificationRepository.MarkRead(ctx,
+		*info.AccountID,
+		uuid.MustParse(command.NotificationID),
+		time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if updated == 0 {
+		return nil, exception.NewNotFound().WithMessage(Err_NotificationNotFound)
+	}
+
+	return &Result{}, nil
+}
@@ -0,0 +1,23 @@
+package mark_notification_read
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	command := Command{NotificationID: "5d2c4f0e-8a41-4b7e-9c1a-3f6e2b8d7a90"}
+	meta.Describe(&command,
+		meta.Description("Mark one of the caller's notifications as read"),
+		meta.Example(&command),
+		meta.Field(&command.NotificationID, meta.Description("Notification to mark as read")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.NotFound](Err_NotificationNotFound),
+		meta.Throws[exception.Internal](Err_Failed))
+}
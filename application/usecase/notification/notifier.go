@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/realtime"
+	"src/core"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/event"
+	"src/domain/repository"
+)
+
+// notificationTTL is how long a notification stays in the inbox.
+const notificationTTL = 30 * 24 * time.Hour
+
+// notifier is the event handler that turns a domain event into
+// notifications for the affected accounts and pushes them to their live
+// connections.
+type notifier[TEvent event.IDomainEvent] struct{}
+
+var _ cqrs.IEventHandler[event.MembershipInvited] = (*notifier[event.MembershipInvited])(nil)
+
+func newNotifier[TEvent event.IDomainEvent]() *notifier[TEvent] {
+	return &notifier[TEvent]{}
+}
+
+func (n *notifier[TEvent]) Handle(ctx context.Context, domainEvent TEvent) error {
+	return notify(ctx, domainEvent)
+}
+
+func registerNotifier[TEvent event.IDomainEvent]() {
+	cqrs.RegisterEventHandler[TEvent, *notifier[TEvent]](newNotifier[TEvent])
+}
+
+func registerAsyncNotifier[TEvent event.IDomainEvent]() {
+	cqrs.RegisterAsyncEventHandler[TEvent, *notifier[TEvent]](newNotifier[TEvent])
+}
+
+func notify(ctx context.Context, domainEvent event.IDomainEvent) error {
+	var (
+		notificationType entity.NotificationTypeEnum
+		tenantID         uuid.UUID
+		recipients       []uuid.UUID
+		err              error
+	)
+
+	switch typed := domainEvent.(type) {
+	case event.MembershipInvited:
+		notificationType, tenantID = entity.NotificationType_MembershipInvited, typed.TenantID
+		recipients, err = invitedRecipients(ctx, typed)
+	case event.MembershipRoleChanged:
+		notificationType, tenantID = entity.NotificationType_MembershipRoleChanged, typed.TenantID
+		recipients = []uuid.UUID{typed.AccountID}
+	case event.BillingPaymentFailed:
+		notificationType, tenantID = entity.NotificationType_BillingPaymentFailed, typed.TenantID
+		recipients, err = tenantAdminRecipients(ctx, typed.TenantID)
+	case event.AccountSessionRevoked:
+		return publish(ctx, typed.AccountID, realtime.Event_SessionRevoked, typed)
+	default:
+		return nil
+	}
+	if err != nil || len(recipients) == 0 {
+		return err
+	}
+
+	payload, err := json.Marshal(domainEvent)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	notifications := make([]entity.NotificationEntity, 0, len(recipients))
+	for _, accountID := range recipients {
+		notifications = append(notifications, entity.NotificationEntity{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			ExpiresAt: now.Add(notificationTTL),
+			Type:      notificationType,
+			Payload:   payload,
+			AccountID: accountID,
+			TenantID:  core.Ptr(tenantID),
+		})
+	}
+
+	if err := di.Resolve[repository.INotificationRepository]().Insert(ctx, notifications); err != nil {
+		return err
+	}
+
+	for index := range notifications {
+		notification := &notifications[index]
+		if err := publish(ctx, notification.AccountID, realtime.Event_Notification, notification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func publish(ctx context.Context, accountID uuid.UUID, eventName string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return di.Resolve[realtime.IRealtimeAdapter]().Publish(ctx, realtime.Message{
+		Event:     eventName,
+		Data:      payload,
+		AccountID: accountID,
+	})
+}
+
+// invitedRecipients notifies the invitee only if the email already has an
+// account; everyone else learns about the invitation by email.
+func invitedRecipients(ctx context.Context, invited event.MembershipInvited) ([]uuid.UUID, error) {
+	account, err := di.Resolve[repository.IAccountRepository]().GetByEmail(ctx, invited.InvitedEmailAddress)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	return []uuid.UUID{account.ID}, nil
+}
+
+func tenantAdminRecipients(ctx context.Context, tenantID uuid.UUID) ([]uuid.UUID, error) {
+	memberships, err := di.Resolve[repository.IMembershipRepository]().ListActiveByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	var recipients []uuid.UUID
+	for _, membership := range memberships {
+		if membership.Role == string(entity.MembershipRole_Admin) {
+			recipients = append(recipients, membership.AccountID)
+		}
+	}
+	return recipients, nil
+}
@@ -1,7 +1,27 @@
 package notification
 
-import "src/application/usecase/notification/query/search_notification"
+import (
+	"src/application/usecase/notification/command/delete_notification"
+	"src/application/usecase/notification/command/mark_all_notification_read"
+	"src/application/usecase/notification/command/mark_notification_read"
+	"src/application/usecase/notification/query/count_unread_notification"
+	"src/application/usecase/notification/query/search_notification"
+	"src/domain/event"
+)
 
 func Register() {
+	// notifications are written once the change committed, through the
+	// outbox and the inbox, so each event notifies exactly once
+	registerAsyncNotifier[event.MembershipInvited]()
+	registerAsyncNotifier[event.MembershipRoleChanged]()
+	registerAsyncNotifier[event.BillingPaymentFailed]()
+	// a revoked session is only pushed to live connections, right away
+	registerNotifier[event.AccountSessionRevoked]()
+
+	delete_notification.Register()
+	mark_all_notification_read.Register()
+	mark_notification_read.Register()
+
+	count_unread_notification.Register()
 	search_notification.Register()
 }
@@ -0,0 +1,55 @@
+package count_unread_notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "counting notifications requires an authenticated account"
+	Err_Failed           = "unread notification count failed"
+)
+
+type Handler struct {
+	notificationRepository repository.INotificationRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	notificationRepository repository.INotificationRepository,
+) *Handler {
+	return &Handler{
+		notificationRepository: notificationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	var tenantID *uuid.UUID
+	if query.TenantID != "" {
+		tenantID = core.Ptr(uuid.MustParse(query.TenantID))
+	}
+
+	unread, err := h.notificationRepository.CountUnread(ctx, *info.AccountID, tenantID, time.Now())
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Unread: unread}, nil
+}
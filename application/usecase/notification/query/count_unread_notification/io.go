@@ -0,0 +1,19 @@
+package count_unread_notification
+
+import "src/core/validator"
+
+type Query struct {
+	TenantID string `json:"tenant_id"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.TenantID).GUID(),
+	).Validate()
+}
+
+type Result struct {
+	Unread int64 `json:"unread"`
+}
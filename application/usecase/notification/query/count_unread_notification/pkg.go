@@ -0,0 +1,28 @@
+package count_unread_notification
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{TenantID: "0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"}
+	meta.Describe(&query,
+		meta.Description("Count the caller's unread, unexpired notifications"),
+		meta.Example(&query),
+		meta.Field(&query.TenantID, meta.Description("Only notifications of this tenant; all of them when empty")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Unread: 4}
+	meta.Describe(&result,
+		meta.Description("Unread notification count"),
+		meta.Example(&result),
+		meta.Field(&result.Unread, meta.Description("Number of unread notifications")))
+}
@@ -0,0 +1,69 @@
+package search_notification
+
+import (
+	"context"
+	"time"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "notification search requires an authenticated account"
+	Err_Failed           = "notification search failed"
+)
+
+type Handler struct {
+	notificationRepository repository.INotificationRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(
+	notificationRepository repository.INotificationRepository,
+) *Handler {
+	return &Handler{
+		notificationRepository: notificationRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+
+	search := builder.NewQuery[entity.NotificationEntity]().
+		Where(func(e *entity.NotificationEntity, q *builder.WhereBuilder[entity.NotificationEntity]) {
+			q.Equal(&e.AccountID, info.AccountID.String()).
+				GreaterThan(&e.ExpiresAt, time.Now().UTC())
+			if query.TenantID != "" {
+				q.Equal(&e.TenantID, query.TenantID)
+			}
+			if query.Type != "" {
+				q.Equal(&e.Type, query.Type)
+			}
+			if query.UnreadOnly {
+				q.Empty(&e.ReadAt)
+			}
+		}).
+		Sort(func(e *entity.NotificationEntity, s *builder.SortBuilder[entity.NotificationEntity]) {
+			s.Desc(&e.CreatedAt)
+		}).
+		Offset(query.Offset).
+		Limit(query.Limit)
+
+	result, err := h.notificationRepository.Search(ctx, search)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package search_notification
+
+import (
+	"src/core/builder"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Query struct {
+	TenantID   string `json:"tenant_id"`
+	Type       string `json:"type"`
+	UnreadOnly bool   `json:"unread_only"`
+	Offset     int64  `json:"offset"`
+	Limit      int64  `json:"limit"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.TenantID).GUID(),
+		validator.String(&q.Type).Allow(
+			string(entity.NotificationType_MembershipInvited),
+			string(entity.NotificationType_MembershipRoleChanged),
+			string(entity.NotificationType_BillingPaymentFailed)),
+		validator.Number(&q.Offset).Integer().Min(0).Default(0),
+		validator.Number(&q.Limit).Integer().Min(1).Max(1000).Default(50),
+	).Validate()
+}
+
+type Result = builder.Result[entity.NotificationEntity]
@@ -1,8 +1,31 @@
 package search_notification
 
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	query := Query{
+		Type:       string(entity.NotificationType_MembershipInvited),
+		UnreadOnly: true,
+		Limit:      50,
+	}
+	meta.Describe(&query,
+		meta.Description("Search the caller's unexpired notifications, newest first"),
+		meta.Example(&query),
+		meta.Field(&query.TenantID, meta.Description("Only notifications of this tenant")),
+		meta.Field(&query.Type, meta.Description("Only notifications of this type")),
+		meta.Field(&query.UnreadOnly, meta.Description("Only notifications not read yet")),
+		meta.Field(&query.Offset, meta.Description("Number of items to skip")),
+		meta.Field(&query.Limit, meta.Description("Maximum number of items to return")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Internal](Err_Failed))
+}
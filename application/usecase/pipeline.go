@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/database"
+	"src/application/adapter/logger"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/domain/event"
+)
+
+// logExecution is the behavior that logs every command and query with its
+// duration.
+func logExecution(ctx context.Context, message any, next cqrs.Next) (any, error) {
+	start := time.Now()
+	result, err := next(ctx)
+	optionalMap := map[string]any{
+		"use_case": cqrs.UseCaseName(message),
+		"ms":       time.Since(start).String(),
+	}
+	if err != nil {
+		optionalMap["err"] = err.Error()
+	}
+	di.Resolve[logger.ILoggerAdapter]().Debug("Use case executed", optionalMap)
+	return result, err
+}
+
+// runInTransaction is the behavior that runs cqrs.ITransactional commands in
+// a unit of work carried by the context: it commits when the handler succeeds
+// and rolls back when it fails. A transactional command executed by another
+// one runs in a savepoint of the outer transaction. Events published by the
+// handler reach their in-process handlers once the transaction committed.
+func runInTransaction(ctx context.Context, command any, next cqrs.Next) (any, error) {
+	if _, ok := command.(cqrs.ITransactional); !ok {
+		return next(ctx)
+	}
+
+	uow, err := di.Resolve[database.IDatabaseAdapter]().BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uow.Rollback(ctx) // no-op once committed
+
+	txCtx, queue := cqrs.WithEventQueue(common.WithUnitOfWork(ctx, uow))
+	result, err := next(txCtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uow.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := queue.Release(ctx); err != nil {
+		logEventErr(command, err)
+	}
+	return result, nil
+}
+
+// publishDomainEvents is the behavior that publishes the domain events a
+// successful command returned in its result. Within a unit of work the events
+// are written to the outbox in the same transaction, so failing to publish
+// them fails the command; otherwise the change is done and a failure is only
+// logged.
+func publishDomainEvents(ctx context.Context, command any, next cqrs.Next) (any, error) {
+	result, err := next(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if source, ok := result.(event.IEventSource); ok {
+		published := make([]cqrs.Event, 0, len(source.DomainEvents()))
+		for _, domainEvent := range source.DomainEvents() {
+			published = append(published, domainEvent)
+		}
+		if err := cqrs.PublishEvent(ctx, published...); err != nil {
+			if common.GetUnitOfWork(ctx) != nil {
+				return nil, err
+			}
+			logEventErr(command, err)
+		}
+	}
+	return result, nil
+}
+
+func logEventErr(command any, err error) {
+	di.Resolve[logger.ILoggerAdapter]().Error("event handling failed", map[string]any{
+		"use_case": cqrs.UseCaseName(command),
+		"err":      err.Error(),
+	})
+}
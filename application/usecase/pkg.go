@@ -1,8 +1,10 @@
 package usecase
 
 import (
+	"src/application/usecase/access_token"
 	"src/application/usecase/activity"
 	"src/application/usecase/billing"
+	"src/application/usecase/certificate"
 	"src/application/usecase/currency"
 	"src/application/usecase/document"
 	"src/application/usecase/identity"
@@ -11,11 +13,20 @@ import (
 	"src/application/usecase/session"
 	"src/application/usecase/system"
 	"src/application/usecase/tenant"
+	"src/core/cqrs"
 )
 
 func Register() {
+	// outermost first: behaviors registered by the use case groups run inside
+	cqrs.RegisterCommandBehavior(logExecution)
+	cqrs.RegisterCommandBehavior(cqrs.ValidationBehavior)
+	cqrs.RegisterQueryBehavior(logExecution)
+	cqrs.RegisterQueryBehavior(cqrs.ValidationBehavior)
+
+	access_token.Register()
 	activity.Register()
 	billing.Register()
+	certificate.Register()
 	currency.Register()
 	document.Register()
 	identity.Register()
@@ -24,4 +35,9 @@ func Register() {
 	session.Register()
 	system.Register()
 	tenant.Register()
+
+	// innermost, so hooks observe the command once it committed, and domain
+	// events reach the outbox within its transaction
+	cqrs.RegisterCommandBehavior(runInTransaction)
+	cqrs.RegisterCommandBehavior(publishDomainEvents)
 }
@@ -1,7 +1,11 @@
 package system
 
-import "src/application/usecase/system/query/healthcheck"
+import (
+	"src/application/usecase/system/query/get_password_range"
+	"src/application/usecase/system/query/healthcheck"
+)
 
 func Register() {
+	get_password_range.Register()
 	healthcheck.Register()
 }
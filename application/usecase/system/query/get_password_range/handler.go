@@ -0,0 +1,35 @@
+package get_password_range
+
+import (
+	"context"
+
+	"src/application/adapter/password"
+	"src/core/cqrs"
+	"src/domain/exception"
+)
+
+const (
+	Err_Failed = "password range lookup failed"
+)
+
+type Handler struct {
+	passwordAdapter password.IPasswordAdapter
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(passwordAdapter password.IPasswordAdapter) *Handler {
+	return &Handler{passwordAdapter: passwordAdapter}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	suffixes, err := h.passwordAdapter.Range(ctx, query.Prefix)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Suffixes: suffixes}, nil
+}
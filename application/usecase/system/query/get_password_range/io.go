@@ -0,0 +1,17 @@
+package get_password_range
+
+import "src/core/validator"
+
+type Query struct {
+	Prefix string `json:"prefix"`
+}
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.Prefix).Trim().Uppercase().Required().Length(5).Hex(),
+	).Validate()
+}
+
+type Result struct {
+	Suffixes map[string]int `json:"suffixes"`
+}
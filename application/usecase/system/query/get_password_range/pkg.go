@@ -0,0 +1,27 @@
+package get_password_range
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{Prefix: "5BAA6"}
+	meta.Describe(&query,
+		meta.Description("K-anonymity range lookup of leaked or common passwords: the SHA-1 suffixes known for a 5 hex character prefix"),
+		meta.Example(&query),
+		meta.Field(&query.Prefix, meta.Description("First 5 hex characters of the password SHA-1")),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Suffixes: map[string]int{"1E4C9B93F3F0682250B6CF8331B7EE68FD8": 1}}
+	meta.Describe(&result,
+		meta.Description("Known suffixes for the prefix"),
+		meta.Example(&result),
+		meta.Field(&result.Suffixes, meta.Description("Remaining 35 hex characters of each known SHA-1, with how often it was seen")))
+}
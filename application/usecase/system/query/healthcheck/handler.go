@@ -9,6 +9,7 @@ import (
 	"src/application/adapter/stream"
 	"src/core/cqrs"
 	"src/domain/exception"
+	"src/domain/repository"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -23,6 +24,7 @@ type Handler struct {
 	cache    cache.ICacheAdapter
 	storage  storage.IStorageAdapter
 	stream   stream.IStreamAdapter
+	outbox   repository.IOutboxMessageRepository
 }
 
 var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
@@ -32,12 +34,14 @@ func New(
 	cache cache.ICacheAdapter,
 	storage storage.IStorageAdapter,
 	stream stream.IStreamAdapter,
+	outbox repository.IOutboxMessageRepository,
 ) *Handler {
 	return &Handler{
 		database: database,
 		cache:    cache,
 		storage:  storage,
 		stream:   stream,
+		outbox:   outbox,
 	}
 }
 
@@ -62,6 +66,28 @@ func (h *Handler) pingAndSetTime(
 	})
 }
 
+// outboxLag reports the messages waiting in the outbox and how long the
+// oldest one has waited.
+func (h *Handler) outboxLag(ctx context.Context, g *errgroup.Group, target **Outbox) {
+	g.Go(func() error {
+		pending, err := h.outbox.CountPending(ctx)
+		if err != nil {
+			return err
+		}
+		oldest, err := h.outbox.GetOldestPending(ctx)
+		if err != nil {
+			return err
+		}
+
+		var lag time.Duration
+		if oldest != nil {
+			lag = time.Since(oldest.CreatedAt)
+		}
+		*target = &Outbox{Pending: pending, Lag: fmt.Sprintf("%dms", lag.Milliseconds())}
+		return nil
+	})
+}
+
 func (h *Handler) Handle(
 	ctx context.Context,
 	query *Query,
@@ -72,6 +98,7 @@ func (h *Handler) Handle(
 	h.pingAndSetTime(ctx, g, h.cache, &result.Cache)
 	h.pingAndSetTime(ctx, g, h.storage, &result.Storage)
 	h.pingAndSetTime(ctx, g, h.stream, &result.Stream)
+	h.outboxLag(ctx, g, &result.Outbox)
 
 	if err := g.Wait(); err != nil {
 		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
@@ -8,4 +8,10 @@ type Result struct {
 	Cache    *string `json:"cache"`
 	Storage  *string `json:"storage"`
 	Stream   *string `json:"stream"`
+	Outbox   *Outbox `json:"outbox"`
+}
+
+type Outbox struct {
+	Pending int64  `json:"pending"`
+	Lag     string `json:"lag"`
 }
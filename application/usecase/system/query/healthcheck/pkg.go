@@ -23,6 +23,7 @@ func registerMeta() {
 		Cache:    core.Ptr("123ms"),
 		Storage:  core.Ptr("123ms"),
 		Stream:   core.Ptr("123ms"),
+		Outbox:   &Outbox{Pending: 0, Lag: "0ms"},
 	}
 	meta.Describe(&result,
 		meta.Description("Health check result"),
@@ -30,6 +31,7 @@ func registerMeta() {
 		meta.Field(&result.Database, meta.Description("Database status, null if failed")),
 		meta.Field(&result.Cache, meta.Description("Cache status, null if failed")),
 		meta.Field(&result.Storage, meta.Description("Storage status, null if failed")),
-		meta.Field(&result.Stream, meta.Description("Stream status, null if failed")))
+		meta.Field(&result.Stream, meta.Description("Stream status, null if failed")),
+		meta.Field(&result.Outbox, meta.Description("Messages waiting in the outbox and age of the oldest, null if failed")))
 
 }
@@ -0,0 +1,100 @@
+package update_membership_role_in_tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/event"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated = "changing a member role requires an authenticated account"
+	Err_NotAdmin         = "only an admin of the tenant can change member roles"
+	Err_NotFound         = "membership not found"
+	Err_LastAdmin        = "the tenant must keep at least one admin"
+	Err_Failed           = "changing the member role failed"
+)
+
+type Handler struct {
+	membershipRepository repository.IMembershipRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(membershipRepository repository.IMembershipRepository) *Handler {
+	return &Handler{membershipRepository: membershipRepository}
+}
+
+// Handle changes the role of an active member and raises
+// MembershipRoleChanged. The memberships of the tenant stay locked until the
+// transaction ends, so two admins demoting each other cannot leave the
+// tenant without one.
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+	tenantID := uuid.MustParse(command.TenantID)
+	membershipID := uuid.MustParse(command.MembershipID)
+	role := entity.MembershipRoleEnum(command.Role)
+
+	memberships, err := h.membershipRepository.LockActiveByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	var target *entity.MembershipEntity
+	admin, admins := false, 0
+	for index := range memberships {
+		membership := &memberships[index]
+		if membership.Role == string(entity.MembershipRole_Admin) {
+			admins++
+			admin = admin || membership.AccountID == *info.AccountID
+		}
+		if membership.ID == membershipID {
+			target = membership
+		}
+	}
+	if !admin {
+		return nil, exception.NewForbidden().WithMessage(Err_NotAdmin)
+	}
+	if target == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_NotFound)
+	}
+
+	previous := *target
+	if target.Role == string(role) {
+		return &Result{Membership: *target, previous: previous}, nil
+	}
+	if target.Role == string(entity.MembershipRole_Admin) && admins == 1 {
+		return nil, exception.NewConflict().WithMessage(Err_LastAdmin)
+	}
+
+	if _, err := h.membershipRepository.UpdateRole(ctx, target.ID, role); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	target.Role = string(role)
+	target.UpdatedAt = time.Now().UTC()
+
+	return &Result{
+		Membership: *target,
+		previous:   previous,
+		events: []event.IDomainEvent{event.MembershipRoleChanged{
+			MembershipID: target.ID,
+			PreviousRole: previous.Role,
+			Role:         target.Role,
+			AccountID:    target.AccountID,
+			TenantID:     tenantID,
+		}},
+	}, nil
+}
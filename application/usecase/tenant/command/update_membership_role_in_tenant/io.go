@@ -0,0 +1,52 @@
+package update_membership_role_in_tenant
+
+import (
+	"src/core/cqrs"
+	"src/core/validator"
+	"src/domain/entity"
+	"src/domain/event"
+)
+
+type Command struct {
+	TenantID     string `json:"tenant_id"`
+	MembershipID string `json:"membership_id"`
+	Role         string `json:"role"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.TenantID).Trim().Lowercase().Required().GUID(),
+		validator.String(&c.MembershipID).Trim().Lowercase().Required().GUID(),
+		validator.String(&c.Role).Trim().Uppercase().Required().Allow(
+			string(entity.MembershipRole_Admin),
+			string(entity.MembershipRole_Manager),
+			string(entity.MembershipRole_Member)),
+	).Validate()
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+func (c *Command) ActivityTarget() (string, string) {
+	return "membership", c.MembershipID
+}
+
+type Result struct {
+	Membership entity.MembershipEntity `json:"membership"`
+	previous   entity.MembershipEntity
+
+	events []event.IDomainEvent
+}
+
+var _ event.IEventSource = (*Result)(nil)
+
+func (r *Result) DomainEvents() []event.IDomainEvent {
+	return r.events
+}
+
+func (r *Result) ActivityChange() (any, any) {
+	return r.previous, r.Membership
+}
@@ -1,8 +1,46 @@
 package update_membership_role_in_tenant
 
+import (
+	"github.com/google/uuid"
+
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/entity"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	command := Command{
+		TenantID:     "0b6e1f4c-3f3a-4d2e-9a4b-6c1d2e3f4a5b",
+		MembershipID: "5d2c8e1a-7b3f-4c9d-8e6a-1f0b2c3d4e5f",
+		Role:         string(entity.MembershipRole_Manager),
+	}
+	meta.Describe(&command,
+		meta.Description("Change the role of an active member of a tenant"),
+		meta.Example(&command),
+		meta.Field(&command.TenantID, meta.Description("Tenant of the membership, the caller must be one of its admins")),
+		meta.Field(&command.MembershipID, meta.Description("Membership to change")),
+		meta.Field(&command.Role, meta.Description("New role: ADMIN, MANAGER or MEMBER")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_NotAdmin),
+		meta.Throws[exception.NotFound](Err_NotFound),
+		meta.Throws[exception.Conflict](Err_LastAdmin),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Membership: entity.MembershipEntity{
+		ID:        uuid.MustParse(command.MembershipID),
+		Role:      command.Role,
+		Status:    string(entity.MembershipStatus_Active),
+		TenantID:  uuid.MustParse(command.TenantID),
+		AccountID: uuid.MustParse("0b8f5a3e-7c1d-4e7a-9f3b-2d6c8a1e4f50"),
+	}}
+	meta.Describe(&result,
+		meta.Description("The updated membership"),
+		meta.Example(&result),
+		meta.Field(&result.Membership, meta.Description("Membership with its new role")))
+}
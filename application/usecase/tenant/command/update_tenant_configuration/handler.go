@@ -0,0 +1,124 @@
+package update_tenant_configuration
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_NotAuthenticated  = "updating a tenant configuration requires an authenticated account"
+	Err_NotAdmin          = "only an admin of the tenant can update its configuration"
+	Err_NotFound          = "tenant configuration not found"
+	Err_InvalidTimezone   = "timezone is not a known IANA time zone"
+	Err_CurrencyNotActive = "currency does not exist or is not active"
+	Err_CallerWithoutMfa  = "enable MFA on your own account before requiring it from the tenant"
+	Err_Failed            = "updating the tenant configuration failed"
+)
+
+type Handler struct {
+	tenantConfigurationRepository repository.ITenantConfigurationRepository
+	membershipRepository          repository.IMembershipRepository
+	currencyRepository            repository.ICurrencyRepository
+	mfaRepository                 repository.IAccountMfaRepository
+}
+
+var _ cqrs.ICommandHandler[*Command, *Result] = (*Handler)(nil)
+
+func New(
+	tenantConfigurationRepository repository.ITenantConfigurationRepository,
+	membershipRepository repository.IMembershipRepository,
+	currencyRepository repository.ICurrencyRepository,
+	mfaRepository repository.IAccountMfaRepository,
+) *Handler {
+	return &Handler{
+		tenantConfigurationRepository: tenantConfigurationRepository,
+		membershipRepository:          membershipRepository,
+		currencyRepository:            currencyRepository,
+		mfaRepository:                 mfaRepository,
+	}
+}
+
+func (h *Handler) Handle(
+	ctx context.Context,
+	command *Command,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return nil, exception.NewUnauthorized().WithMessage(Err_NotAuthenticated)
+	}
+	tenantID := uuid.MustParse(command.TenantID)
+
+	memberships, err := h.membershipRepository.ListActiveByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	admin := false
+	for _, membership := range memberships {
+		if membership.TenantID == tenantID && membership.Role == string(entity.MembershipRole_Admin) {
+			admin = true
+			break
+		}
+	}
+	if !admin {
+		return nil, exception.NewForbidden().WithMessage(Err_NotAdmin)
+	}
+
+	configuration, err := h.tenantConfigurationRepository.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	if configuration == nil {
+		return nil, exception.NewNotFound().WithMessage(Err_NotFound)
+	}
+	previous := *configuration
+
+	if command.DefaultTimezone != nil && *command.DefaultTimezone != "" {
+		if _, err := time.LoadLocation(*command.DefaultTimezone); err != nil {
+			return nil, exception.NewUnprocessableEntity().WithCause(err).WithMessage(Err_InvalidTimezone)
+		}
+		configuration.DefaultTimezone = *command.DefaultTimezone
+	}
+
+	if command.DefaultCurrencyCode != nil && *command.DefaultCurrencyCode != "" {
+		code := strings.ToUpper(*command.DefaultCurrencyCode)
+		// shared lock: the currency cannot be deactivated before this commits
+		currency, err := h.currencyRepository.LockByCode(ctx, code, false)
+		if err != nil {
+			return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+		}
+		if currency == nil || !currency.IsActive {
+			return nil, exception.NewUnprocessableEntity().WithMessage(Err_CurrencyNotActive)
+		}
+		configuration.DefaultCurrencyCode = code
+	}
+
+	if command.RequireMfa != nil {
+		// an admin must not lock themselves out of the tenant they just secured
+		if *command.RequireMfa && !configuration.RequireMfa {
+			mfa, err := h.mfaRepository.GetByAccountID(ctx, *info.AccountID)
+			if err != nil {
+				return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+			}
+			if !mfa.Enabled() {
+				return nil, exception.NewUnprocessableEntity().WithMessage(Err_CallerWithoutMfa)
+			}
+		}
+		configuration.RequireMfa = *command.RequireMfa
+	}
+
+	configuration.UpdatedAt = time.Now()
+	if _, err := h.tenantConfigurationRepository.Update(ctx, configuration); err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+
+	return &Result{Configuration: *configuration, previous: previous}, nil
+}
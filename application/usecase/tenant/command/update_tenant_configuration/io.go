@@ -0,0 +1,41 @@
+package update_tenant_configuration
+
+import (
+	"src/core/cqrs"
+	"src/core/validator"
+	"src/domain/entity"
+)
+
+type Command struct {
+	TenantID            string  `json:"tenant_id"`
+	DefaultTimezone     *string `json:"default_timezone"`
+	DefaultCurrencyCode *string `json:"default_currency_code"`
+	RequireMfa          *bool   `json:"require_mfa"`
+}
+
+var _ validator.IValidable = (*Command)(nil)
+
+func (c *Command) Validate() error {
+	return validator.Object(c,
+		validator.String(&c.TenantID).Trim().Lowercase().Required().GUID(),
+		validator.String(&c.DefaultTimezone).Trim().Max(64),
+		validator.String(&c.DefaultCurrencyCode).Trim().Length(3),
+	).Validate()
+}
+
+func (c *Command) ActivityTarget() (string, string) {
+	return "tenant_configuration", c.TenantID
+}
+
+var _ cqrs.ITransactional = (*Command)(nil)
+
+func (c *Command) Transactional() {}
+
+type Result struct {
+	Configuration entity.TenantConfigurationEntity `json:"configuration"`
+	previous      entity.TenantConfigurationEntity
+}
+
+func (r *Result) ActivityChange() (any, any) {
+	return r.previous, r.Configuration
+}
@@ -1,8 +1,36 @@
 package update_tenant_configuration
 
+import (
+	"src/core"
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
 func Register() {
 	registerMeta()
-	// cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+	cqrs.RegisterCommandHandler[*Command, *Result, *Handler](New)
 }
 
-func registerMeta() {}
+func registerMeta() {
+	command := Command{
+		TenantID:            "0b6e1f4c-3f3a-4d2e-9a4b-6c1d2e3f4a5b",
+		DefaultTimezone:     core.Ptr("Europe/Paris"),
+		DefaultCurrencyCode: core.Ptr("EUR"),
+		RequireMfa:          core.Ptr(true),
+	}
+	meta.Describe(&command,
+		meta.Description("Update the defaults and the MFA requirement of a tenant. Omitted fields are left unchanged"),
+		meta.Example(&command),
+		meta.Field(&command.TenantID, meta.Description("Tenant to update, the caller must be one of its admins")),
+		meta.Field(&command.DefaultTimezone, meta.Description("IANA time zone used when a member has none")),
+		meta.Field(&command.DefaultCurrencyCode, meta.Description("ISO 4217 code of an active currency")),
+		meta.Field(&command.RequireMfa, meta.Description("Ask every member to sign in with TOTP. The caller must have MFA enabled to turn it on")),
+		meta.Throws[exception.Unauthorized](Err_NotAuthenticated),
+		meta.Throws[exception.Forbidden](Err_NotAdmin),
+		meta.Throws[exception.NotFound](Err_NotFound),
+		meta.Throws[exception.UnprocessableEntity](Err_InvalidTimezone),
+		meta.Throws[exception.UnprocessableEntity](Err_CurrencyNotActive),
+		meta.Throws[exception.UnprocessableEntity](Err_CallerWithoutMfa),
+		meta.Throws[exception.Internal](Err_Failed))
+}
@@ -9,6 +9,7 @@ import (
 	"src/application/usecase/tenant/command/update_tenant"
 	"src/application/usecase/tenant/command/update_tenant_configuration"
 	"src/application/usecase/tenant/command/upload_tenant_picture"
+	"src/application/usecase/tenant/query/authorize_tenant_member"
 	"src/application/usecase/tenant/query/check_subdomain_availability"
 	"src/application/usecase/tenant/query/get_tenant_configuration"
 	"src/application/usecase/tenant/query/get_tenant_picture"
@@ -26,6 +27,7 @@ func Register() {
 	update_tenant_configuration.Register()
 	upload_tenant_picture.Register()
 
+	authorize_tenant_member.Register()
 	check_subdomain_availability.Register()
 	get_tenant_configuration.Register()
 	get_tenant_picture.Register()
@@ -0,0 +1,50 @@
+package authorize_tenant_member
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/exception"
+	"src/domain/repository"
+)
+
+const (
+	Err_Failed = "tenant membership check failed"
+)
+
+type Handler struct {
+	membershipRepository repository.IMembershipRepository
+}
+
+var _ cqrs.IQueryHandler[*Query, *Result] = (*Handler)(nil)
+
+func New(membershipRepository repository.IMembershipRepository) *Handler {
+	return &Handler{membershipRepository: membershipRepository}
+}
+
+// Handle tells whether the authenticated account is an active member of the
+// tenant; anonymous callers never are.
+func (h *Handler) Handle(
+	ctx context.Context,
+	query *Query,
+) (*Result, error) {
+	info := common.GetRequestInfo(ctx)
+	if info.AccountID == nil {
+		return &Result{}, nil
+	}
+	tenantID := uuid.MustParse(query.TenantID)
+
+	memberships, err := h.membershipRepository.ListActiveByAccountID(ctx, *info.AccountID)
+	if err != nil {
+		return nil, exception.NewInternal().WithCause(err).WithMessage(Err_Failed)
+	}
+	for _, membership := range memberships {
+		if membership.TenantID == tenantID {
+			return &Result{Member: true}, nil
+		}
+	}
+	return &Result{}, nil
+}
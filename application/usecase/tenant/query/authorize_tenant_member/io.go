@@ -0,0 +1,19 @@
+package authorize_tenant_member
+
+import "src/core/validator"
+
+type Query struct {
+	TenantID string `json:"tenant_id"`
+}
+
+var _ validator.IValidable = (*Query)(nil)
+
+func (q *Query) Validate() error {
+	return validator.Object(q,
+		validator.String(&q.TenantID).Trim().Lowercase().Required().GUID(),
+	).Validate()
+}
+
+type Result struct {
+	Member bool `json:"member"`
+}
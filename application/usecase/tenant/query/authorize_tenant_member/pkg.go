@@ -0,0 +1,27 @@
+package authorize_tenant_member
+
+import (
+	"src/core/cqrs"
+	"src/core/meta"
+	"src/domain/exception"
+)
+
+func Register() {
+	registerMeta()
+	cqrs.RegisterQueryHandler[*Query, *Result, *Handler](New)
+}
+
+func registerMeta() {
+	query := Query{TenantID: "0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"}
+	meta.Describe(&query,
+		meta.Description("Check that the authenticated account is an active member of a tenant"),
+		meta.Example(&query),
+		meta.Field(&query.TenantID, meta.Description("Tenant named by the request")),
+		meta.Throws[exception.Internal](Err_Failed))
+
+	result := Result{Member: true}
+	meta.Describe(&result,
+		meta.Description("Tenant membership of the caller"),
+		meta.Example(&result),
+		meta.Field(&result.Member, meta.Description("Whether the caller is an active member of the tenant")))
+}
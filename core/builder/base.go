@@ -1,49 +1,78 @@
 package builder
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
 )
 
-type builderBase struct{}
+type builderBase struct {
+	// entity points to the instance handed to builder callbacks; field
+	// pointers are resolved by locating them inside it.
+	entity reflect.Value
+}
+
+func newBuilderBase[TEntity any]() builderBase {
+	return builderBase{entity: reflect.ValueOf(new(TEntity))}
+}
 
 func (b *builderBase) fieldPointerJSONTag(fieldPointer any) string {
 	pointerValue := reflect.ValueOf(fieldPointer)
-	if pointerValue.Kind() != reflect.Pointer {
+	if pointerValue.Kind() != reflect.Pointer || pointerValue.IsNil() {
 		panic("fieldPointer must be a pointer to a struct field.")
 	}
 
-	parentObject := pointerValue.Elem().Addr()
-	if parentObject.Kind() != reflect.Pointer {
-		panic(fmt.Sprintf("Invalid pointer type: expected addressable struct element, got %v", parentObject.Kind()))
+	if !b.entity.IsValid() || b.entity.Elem().Kind() != reflect.Struct {
+		panic("builder entity must be a struct")
+	}
+
+	path, ok := b.jsonPathOf(b.entity.Elem(), pointerValue)
+	if !ok {
+		panic("fieldPointer must point to a field (with a \"json\" tag) of the entity passed to the builder")
 	}
 
-	parentObjectType := parentObject.Elem().Type()
-	for i := 0; i < parentObjectType.NumField(); i++ {
-		structField := parentObjectType.Field(i)
+	return path
+}
+
+// jsonPathOf finds the field addressed by pointerValue inside structValue and
+// returns its json name. Fields of nested structs are returned as dotted paths
+// ("total_amount.amount").
+func (b *builderBase) jsonPathOf(structValue reflect.Value, pointerValue reflect.Value) (string, bool) {
+	address := pointerValue.Pointer()
+	structType := structValue.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
 		if !structField.IsExported() {
 			continue
 		}
 
-		field := parentObject.Elem().Field(i)
-		if field.CanAddr() && field.Addr().Interface() == fieldPointer {
-			tag := structField.Tag.Get("json")
+		field := structValue.Field(i)
+		start := field.UnsafeAddr()
+		if address < start || address >= start+structField.Type.Size() {
+			continue
+		}
+
+		tag := structField.Tag.Get("json")
+		if idx := strings.Index(tag, ","); idx != -1 {
+			tag = tag[:idx]
+		}
+
+		if address == start && field.Addr().Type() == pointerValue.Type() {
 			if tag == "" {
 				panic("field must contain \"json\" tag")
 			}
-
-			if idx := strings.Index(tag, ","); idx != -1 {
-				tag = tag[:idx]
-			}
-
-			if tag == "" || tag == "-" {
+			if tag == "-" {
 				panic("field must have a valid json name")
 			}
+			return tag, true
+		}
 
-			return tag
+		if field.Kind() == reflect.Struct && tag != "" && tag != "-" {
+			if nested, ok := b.jsonPathOf(field, pointerValue); ok {
+				return tag + "." + nested, true
+			}
 		}
 	}
 
-	panic("fieldPointer must point to a struct field with a \"json\" tag")
+	return "", false
 }
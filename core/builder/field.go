@@ -15,7 +15,12 @@ type FieldBuilder[TEntity any] struct {
 type FieldFn[TEntity any] func(entity *TEntity, fieldBuilder *FieldBuilder[TEntity])
 
 func NewField[TEntity any]() *FieldBuilder[TEntity] {
-	return &FieldBuilder[TEntity]{builderBase: builderBase{}, Field: Field{}}
+	return &FieldBuilder[TEntity]{builderBase: newBuilderBase[TEntity](), Field: Field{}}
+}
+
+// Entity returns the instance whose field pointers this builder resolves.
+func (b *FieldBuilder[TEntity]) Entity() *TEntity {
+	return b.builderBase.entity.Interface().(*TEntity)
 }
 
 func (b *FieldBuilder[TEntity]) removeFieldName(list []string, fieldName string) []string {
@@ -9,6 +9,20 @@ import (
 	"time"
 )
 
+type LockEnum string
+
+const (
+	// LockEnum_ForUpdateSkipLocked locks the rows read until the transaction
+	// ends and skips rows already locked by another one.
+	LockEnum_ForUpdateSkipLocked LockEnum = "for_update_skip_locked"
+	// LockEnum_ForUpdate locks the rows read until the transaction ends,
+	// waiting for other transactions holding any lock on them.
+	LockEnum_ForUpdate LockEnum = "for_update"
+	// LockEnum_ForShare keeps the rows read from being changed until the
+	// transaction ends, while other ones may still read and share-lock them.
+	LockEnum_ForShare LockEnum = "for_share"
+)
+
 type Query[TEntity any] struct {
 	TextCond   *string          `json:"text,omitempty"`
 	WhereCond  *WherePointerMap `json:"where,omitempty"`
@@ -16,6 +30,7 @@ type Query[TEntity any] struct {
 	SortCond   *SortPointerMap  `json:"sort,omitempty"`
 	LimitCond  *int64           `json:"limit,omitempty"`
 	OffsetCond *int64           `json:"offset,omitempty"`
+	LockCond   *LockEnum        `json:"-"` // never taken from client input
 }
 
 var _ json.Marshaler = (*Query[any])(nil)
@@ -29,8 +44,27 @@ func (q *Query[TEntity]) normalizeWhereTypes() error {
 	if q.WhereCond == nil {
 		return nil
 	}
+	return q.normalizeWhereMap(*q.WhereCond)
+}
+
+func (q *Query[TEntity]) normalizeWhereMap(where WherePointerMap) error {
+	moved := WherePointerMap{}
+
+	for fieldName, ops := range where {
+		if IsWhereGroupKey(fieldName) {
+			for op, rawVal := range ops {
+				group, err := AsWherePointerMap(rawVal)
+				if err != nil {
+					return fmt.Errorf("group %s operator %s: %w", fieldName, op, err)
+				}
+				if err := q.normalizeWhereMap(group); err != nil {
+					return err
+				}
+				ops[op] = group
+			}
+			continue
+		}
 
-	for fieldName, ops := range *q.WhereCond {
 		fieldType, ok := q.fieldTypeByJSONTag(fieldName)
 		if !ok || fieldType == nil {
 			continue
@@ -38,15 +72,33 @@ func (q *Query[TEntity]) normalizeWhereTypes() error {
 
 		for op, rawVal := range ops {
 			switch op {
-			case WhereEnum_Empty, WhereEnum_NotEmpty:
+			case WhereEnum_Empty, WhereEnum_NotEmpty, WhereEnum_Contains, WhereEnum_ArrayContains:
 				continue
 			case WhereEnum_In, WhereEnum_NotIn:
+				if isWhereOperandType(fieldType) {
+					operands, err := q.decodeWhereOperandSlice(rawVal, fieldType)
+					if err != nil {
+						return fmt.Errorf("field %s operator %s: %w", fieldName, op, err)
+					}
+					delete(ops, op)
+					addWhereClause(moved, fieldName, op, operands)
+					continue
+				}
 				normalized, err := q.normalizeWhereSliceValue(rawVal, fieldType)
 				if err != nil {
 					return fmt.Errorf("field %s operator %s: %w", fieldName, op, err)
 				}
 				ops[op] = normalized
 			default:
+				if isWhereOperandType(fieldType) {
+					operand, err := q.decodeWhereOperand(rawVal, fieldType)
+					if err != nil {
+						return fmt.Errorf("field %s operator %s: %w", fieldName, op, err)
+					}
+					delete(ops, op)
+					addWhereClause(moved, fieldName, op, operand)
+					continue
+				}
 				normalized, err := q.normalizeWhereScalarValue(rawVal, fieldType)
 				if err != nil {
 					return fmt.Errorf("field %s operator %s: %w", fieldName, op, err)
@@ -54,22 +106,75 @@ func (q *Query[TEntity]) normalizeWhereTypes() error {
 				ops[op] = normalized
 			}
 		}
+
+		if len(ops) == 0 {
+			delete(where, fieldName)
+		}
+	}
+
+	for path, ops := range moved {
+		if IsWhereGroupKey(path) {
+			for op, group := range ops {
+				addWhereGroup(where, op, group.(WherePointerMap))
+			}
+			continue
+		}
+		if _, exists := where[path]; !exists {
+			where[path] = make(map[WhereEnum]any)
+		}
+		for op, value := range ops {
+			where[path][op] = value
+		}
 	}
 
 	return nil
 }
 
+// decodeWhereOperandSlice decodes every item of a JSON array as
+// decodeWhereOperand does.
+func (q *Query[TEntity]) decodeWhereOperandSlice(value any, elemType reflect.Type) (any, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected slice/array for IN/NIN, got %T", value)
+	}
+
+	operands := make([]any, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		operand, err := q.decodeWhereOperand(v.Index(i).Interface(), elemType)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	return operands, nil
+}
+
 func (q *Query[TEntity]) fieldTypeByJSONTag(fieldName string) (reflect.Type, bool) {
 	var entity TEntity
 	t := reflect.TypeOf(entity)
 
-	if t.Kind() == reflect.Pointer {
-		t = t.Elem()
+	for _, segment := range strings.Split(fieldName, ".") {
+		if t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		fieldType, ok := q.structFieldTypeByJSONTag(t, segment)
+		if !ok {
+			return nil, false
+		}
+		t = fieldType
 	}
-	if t.Kind() != reflect.Struct {
-		return nil, false
+
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
 	}
+	return t, t != nil
+}
 
+func (q *Query[TEntity]) structFieldTypeByJSONTag(t reflect.Type, fieldName string) (reflect.Type, bool) {
 	for i := 0; i < t.NumField(); i++ {
 		f := t.Field(i)
 		if !f.IsExported() {
@@ -86,17 +191,33 @@ func (q *Query[TEntity]) fieldTypeByJSONTag(fieldName string) (reflect.Type, boo
 		}
 
 		if tag == fieldName {
-			ft := f.Type
-			if ft.Kind() == reflect.Pointer {
-				ft = ft.Elem()
-			}
-			return ft, true
+			return f.Type, true
 		}
 	}
 
 	return nil, false
 }
 
+func isWhereOperandType(t reflect.Type) bool {
+	return t != nil && reflect.PointerTo(t).Implements(reflect.TypeFor[IWhereOperand]())
+}
+
+// decodeWhereOperand decodes a JSON value into a type implementing
+// IWhereOperand (e.g. Money), which addWhereClause turns into its member path
+// and plain value.
+func (q *Query[TEntity]) decodeWhereOperand(value any, targetType reflect.Type) (IWhereOperand, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := reflect.New(targetType)
+	if err := json.Unmarshal(raw, decoded.Interface()); err != nil {
+		return nil, err
+	}
+	return decoded.Interface().(IWhereOperand), nil
+}
+
 func (q *Query[TEntity]) normalizeWhereScalarValue(value any, targetType reflect.Type) (any, error) {
 	if value == nil || targetType == nil {
 		return value, nil
@@ -230,9 +351,8 @@ func (q *Query[TEntity]) Where(fn WhereFn[TEntity]) *Query[TEntity] {
 		return q
 	}
 
-	var entity TEntity
 	whereBuilder := NewWhere[TEntity]()
-	fn(&entity, whereBuilder)
+	fn(whereBuilder.Entity(), whereBuilder)
 
 	if len(whereBuilder.PointerMap) == 0 {
 		q.WhereCond = nil
@@ -249,9 +369,8 @@ func (q *Query[TEntity]) Field(fn FieldFn[TEntity]) *Query[TEntity] {
 		return q
 	}
 
-	var entity TEntity
 	fieldBuilder := NewField[TEntity]()
-	fn(&entity, fieldBuilder)
+	fn(fieldBuilder.Entity(), fieldBuilder)
 
 	if len(fieldBuilder.Field.Select) == 0 && len(fieldBuilder.Field.Remove) == 0 {
 		q.FieldCond = nil
@@ -268,9 +387,8 @@ func (q *Query[TEntity]) Sort(fn SortFn[TEntity]) *Query[TEntity] {
 		return q
 	}
 
-	var entity TEntity
 	sortBuilder := NewSort[TEntity]()
-	fn(&entity, sortBuilder)
+	fn(sortBuilder.Entity(), sortBuilder)
 
 	if len(sortBuilder.PointerMap) == 0 {
 		q.SortCond = nil
@@ -291,6 +409,12 @@ func (q *Query[TEntity]) Limit(limit int64) *Query[TEntity] {
 	return q
 }
 
+// Lock locks the rows read; it only has an effect inside a transaction.
+func (q *Query[TEntity]) Lock(lock LockEnum) *Query[TEntity] {
+	q.LockCond = &lock
+	return q
+}
+
 func (q *Query[TEntity]) ToJSON() *Query[json.RawMessage] {
 	if q == nil {
 		return nil
@@ -303,6 +427,7 @@ func (q *Query[TEntity]) ToJSON() *Query[json.RawMessage] {
 		SortCond:   q.SortCond,
 		LimitCond:  q.LimitCond,
 		OffsetCond: q.OffsetCond,
+		LockCond:   q.LockCond,
 	}
 }
 
@@ -17,7 +17,12 @@ type SortBuilder[TEntity any] struct {
 type SortFn[TEntity any] func(entity *TEntity, sortBuilder *SortBuilder[TEntity])
 
 func NewSort[TEntity any]() *SortBuilder[TEntity] {
-	return &SortBuilder[TEntity]{builderBase: builderBase{}, PointerMap: make(SortPointerMap)}
+	return &SortBuilder[TEntity]{builderBase: newBuilderBase[TEntity](), PointerMap: make(SortPointerMap)}
+}
+
+// Entity returns the instance whose field pointers this builder resolves.
+func (b *SortBuilder[TEntity]) Entity() *TEntity {
+	return b.builderBase.entity.Interface().(*TEntity)
 }
 
 func (s *SortBuilder[TEntity]) Desc(fieldPointer any) {
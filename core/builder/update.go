@@ -11,11 +11,16 @@ type UpdateBuilder[TEntity any] struct {
 
 func NewUpdate[TEntity any]() *UpdateBuilder[TEntity] {
 	return &UpdateBuilder[TEntity]{
-		builderBase: builderBase{},
+		builderBase: newBuilderBase[TEntity](),
 		Changes:     make(map[string]any),
 	}
 }
 
+// Entity returns the instance whose field pointers this builder resolves.
+func (b *UpdateBuilder[TEntity]) Entity() *TEntity {
+	return b.builderBase.entity.Interface().(*TEntity)
+}
+
 func (b *UpdateBuilder[TEntity]) Set(fieldPointer any, value any) *UpdateBuilder[TEntity] {
 	fieldName := b.builderBase.fieldPointerJSONTag(fieldPointer)
 	b.Changes[fieldName] = value
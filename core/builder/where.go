@@ -2,7 +2,10 @@ package builder
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
 type WhereEnum string
@@ -24,10 +27,218 @@ const (
 	WhereEnum_NotLowerThan    WhereEnum = "nlt"
 	WhereEnum_LowerEqual      WhereEnum = "lte"
 	WhereEnum_NotLowerEqual   WhereEnum = "nlte"
+	// WhereEnum_Contains matches JSON values containing the given one
+	WhereEnum_Contains WhereEnum = "contains"
+	// WhereEnum_ArrayContains matches JSON arrays holding the given element
+	WhereEnum_ArrayContains WhereEnum = "acontains"
+
+	// group operators, under a "$<n>" key, holding a WherePointerMap
+	WhereEnum_And WhereEnum = "and"
+	WhereEnum_Or  WhereEnum = "or"
+	WhereEnum_Not WhereEnum = "not"
 )
 
+// WherePointerMap maps JSON field paths to their conditions, all of which
+// must hold. Keys starting with "$" hold a condition group instead: a single
+// WhereEnum_And, WhereEnum_Or or WhereEnum_Not operator whose value is the
+// WherePointerMap of the group, e.g.
+//
+//	{"status": {"eq": "ACTIVE"}, "$1": {"or": {"plan": {"eq": "PRO"}, "trial": {"eq": true}}}}
+//
+// In an Or group every condition is an alternative; a Not group negates its
+// conditions taken together.
 type WherePointerMap map[string]map[WhereEnum]any
 
+// WherePath addresses a member nested inside a JSON field, see
+// WhereBuilder.Path.
+type WherePath string
+
+// IsWhereGroupKey tells whether a WherePointerMap key holds a condition
+// group rather than a field.
+func IsWhereGroupKey(key string) bool {
+	return strings.HasPrefix(key, "$")
+}
+
+// AsWherePointerMap returns the WherePointerMap of a group, which is a plain
+// JSON object when the conditions were decoded from JSON.
+func AsWherePointerMap(value any) (WherePointerMap, error) {
+	if group, ok := value.(WherePointerMap); ok {
+		return group, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var group WherePointerMap
+	if err := json.Unmarshal(raw, &group); err != nil {
+		return nil, fmt.Errorf("condition group: %w", err)
+	}
+	return group, nil
+}
+
+// IWhereOperand is implemented by value types stored as JSON objects (or
+// custom encodings) that must be compared through a member. WhereMember
+// returns the JSON member to compare ("" compares the field itself) and
+// WhereValue the plain value used as the SQL argument.
+type IWhereOperand interface {
+	WhereMember() string
+	WhereValue() any
+}
+
+// IWhereScopedOperand is an IWhereOperand only comparable to values sharing
+// its scope, such as amounts of the same currency. WhereScope returns the JSON
+// members that must equal the given values for a comparison to hold.
+type IWhereScopedOperand interface {
+	IWhereOperand
+	WhereScope() map[string]any
+}
+
+// addWhereClause stores a condition on fieldName into where. A scoped operand
+// is stored as a group that also requires its scope, Not operators included:
+// 100 USD never equals 100 EUR, and NotEqual on 100 USD only matches other
+// USD amounts. A condition whose field already has one with the same operator
+// gets a "$<n>" group of its own rather than replacing it, so
+// Or(status = A; status = B) keeps both alternatives.
+func addWhereClause(where WherePointerMap, fieldName string, operator WhereEnum, value any) {
+	if operands := scopedWhereOperands(value); operands != nil {
+		groupOperator, group := scopedWhereGroup(fieldName, operator, operands)
+		addWhereGroup(where, groupOperator, group)
+		return
+	}
+
+	fieldName, value = unwrapWhereOperand(fieldName, value)
+	if _, exists := where[fieldName][operator]; exists {
+		addWhereGroup(where, WhereEnum_And, WherePointerMap{fieldName: {operator: value}})
+		return
+	}
+	if _, exists := where[fieldName]; !exists {
+		where[fieldName] = make(map[WhereEnum]any)
+	}
+	where[fieldName][operator] = value
+}
+
+// addWhereGroup stores group under the next free "$<n>" key of where.
+func addWhereGroup(where WherePointerMap, operator WhereEnum, group WherePointerMap) {
+	groups := 0
+	for key := range where {
+		if IsWhereGroupKey(key) {
+			groups++
+		}
+	}
+	key := "$" + strconv.Itoa(groups+1)
+	for where[key] != nil {
+		groups++
+		key = "$" + strconv.Itoa(groups+1)
+	}
+	where[key] = map[WhereEnum]any{operator: group}
+}
+
+// scopedWhereOperands returns value as a list of scoped operands, nil when it
+// is neither one of them nor a non-empty slice of them.
+func scopedWhereOperands(value any) []IWhereScopedOperand {
+	if operand, ok := value.(IWhereScopedOperand); ok {
+		return []IWhereScopedOperand{operand}
+	}
+
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Len() == 0 {
+		return nil
+	}
+	operands := make([]IWhereScopedOperand, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		operand, ok := v.Index(i).Interface().(IWhereScopedOperand)
+		if !ok {
+			return nil
+		}
+		operands = append(operands, operand)
+	}
+	return operands
+}
+
+// scopedWhereGroup compares fieldName with operands, each within its own
+// scope; operands of several scopes (an IN over USD and EUR amounts) give one
+// alternative per scope, and values of other scopes match none of them.
+func scopedWhereGroup(fieldName string, operator WhereEnum, operands []IWhereScopedOperand) (WhereEnum, WherePointerMap) {
+	var scopes []string
+	byScope := map[string][]IWhereScopedOperand{}
+	for _, operand := range operands {
+		encoded, _ := json.Marshal(operand.WhereScope())
+		scope := string(encoded)
+		if _, exists := byScope[scope]; !exists {
+			scopes = append(scopes, scope)
+		}
+		byScope[scope] = append(byScope[scope], operand)
+	}
+
+	alternatives := make([]WherePointerMap, 0, len(scopes))
+	for _, scope := range scopes {
+		scoped := byScope[scope]
+		var value any = scoped[0]
+		if operator == WhereEnum_In || operator == WhereEnum_NotIn {
+			items := make([]any, len(scoped))
+			for i, operand := range scoped {
+				items[i] = operand
+			}
+			value = items
+		}
+
+		path, plain := unwrapWhereOperand(fieldName, value)
+		alternative := WherePointerMap{path: {operator: plain}}
+		for member, memberValue := range scoped[0].WhereScope() {
+			alternative[fieldName+"."+member] = map[WhereEnum]any{WhereEnum_Equal: memberValue}
+		}
+		alternatives = append(alternatives, alternative)
+	}
+
+	if len(alternatives) == 1 {
+		return WhereEnum_And, alternatives[0]
+	}
+	group := WherePointerMap{}
+	for _, alternative := range alternatives {
+		addWhereGroup(group, WhereEnum_And, alternative)
+	}
+	return WhereEnum_Or, group
+}
+
+// whereOperandPath joins a field name and an operand member using the dotted
+// notation understood by the database adapters.
+func whereOperandPath(fieldName string, operand IWhereOperand) string {
+	if member := operand.WhereMember(); member != "" {
+		return fieldName + "." + member
+	}
+	return fieldName
+}
+
+// unwrapWhereOperand resolves IWhereOperand values (or slices of them) into
+// the path and plain value to store in the pointer map.
+func unwrapWhereOperand(fieldName string, value any) (string, any) {
+	if operand, ok := value.(IWhereOperand); ok {
+		return whereOperandPath(fieldName, operand), operand.WhereValue()
+	}
+
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Len() == 0 {
+		return fieldName, value
+	}
+	if _, ok := v.Index(0).Interface().(IWhereOperand); !ok {
+		return fieldName, value
+	}
+
+	path := fieldName
+	values := make([]any, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		operand := v.Index(i).Interface().(IWhereOperand)
+		path = whereOperandPath(fieldName, operand)
+		values[i] = operand.WhereValue()
+	}
+	out := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(values[0])), 0, len(values))
+	for _, item := range values {
+		out = reflect.Append(out, reflect.ValueOf(item))
+	}
+	return path, out.Interface()
+}
+
 type WhereBuilder[TEntity any] struct {
 	builderBase
 	PointerMap WherePointerMap
@@ -36,15 +247,20 @@ type WhereBuilder[TEntity any] struct {
 type WhereFn[TEntity any] func(entity *TEntity, whereBuilder *WhereBuilder[TEntity])
 
 func NewWhere[TEntity any]() *WhereBuilder[TEntity] {
-	return &WhereBuilder[TEntity]{builderBase: builderBase{}, PointerMap: make(WherePointerMap)}
+	return &WhereBuilder[TEntity]{builderBase: newBuilderBase[TEntity](), PointerMap: make(WherePointerMap)}
+}
+
+// Entity returns the instance whose field pointers this builder resolves.
+func (b *WhereBuilder[TEntity]) Entity() *TEntity {
+	return b.builderBase.entity.Interface().(*TEntity)
 }
 
 func (b *WhereBuilder[TEntity]) addClause(fieldPointer any, operator WhereEnum, value any) {
-	fieldName := b.builderBase.fieldPointerJSONTag(fieldPointer)
-	if _, exists := b.PointerMap[fieldName]; !exists {
-		b.PointerMap[fieldName] = make(map[WhereEnum]any)
+	path, isPath := fieldPointer.(WherePath)
+	if !isPath {
+		path = WherePath(b.builderBase.fieldPointerJSONTag(fieldPointer))
 	}
-	b.PointerMap[fieldName][operator] = value
+	addWhereClause(b.PointerMap, string(path), operator, value)
 }
 
 func (b *WhereBuilder[TEntity]) Equal(fieldPointer any, value any) *WhereBuilder[TEntity] {
@@ -133,6 +349,55 @@ func (b *WhereBuilder[TEntity]) NotLowerEqual(fieldPointer any, value any) *Wher
 	return b
 }
 
+// Contains matches fields whose JSON value contains value, as JSONB @> does:
+// objects match on a subset of their members, arrays on a subset of their
+// elements.
+func (b *WhereBuilder[TEntity]) Contains(fieldPointer any, value any) *WhereBuilder[TEntity] {
+	b.addClause(fieldPointer, WhereEnum_Contains, value)
+	return b
+}
+
+// ArrayContains matches fields holding a JSON array with element among its
+// elements.
+func (b *WhereBuilder[TEntity]) ArrayContains(fieldPointer any, element any) *WhereBuilder[TEntity] {
+	b.addClause(fieldPointer, WhereEnum_ArrayContains, element)
+	return b
+}
+
+// Path addresses a member nested inside the JSON of a field the entity does
+// not describe, such as a map or a json.RawMessage; use it in place of a
+// field pointer:
+//
+//	q.Equal(q.Path(&e.Metadata, "plan", "tier"), "pro")
+func (b *WhereBuilder[TEntity]) Path(fieldPointer any, segments ...string) WherePath {
+	return WherePath(strings.Join(append([]string{b.builderBase.fieldPointerJSONTag(fieldPointer)}, segments...), "."))
+}
+
+// And groups the conditions added by fn, which must all hold; it is only
+// needed inside Or.
+func (b *WhereBuilder[TEntity]) And(fn WhereFn[TEntity]) *WhereBuilder[TEntity] {
+	return b.addGroup(WhereEnum_And, fn)
+}
+
+// Or groups the conditions added by fn, of which at least one must hold.
+func (b *WhereBuilder[TEntity]) Or(fn WhereFn[TEntity]) *WhereBuilder[TEntity] {
+	return b.addGroup(WhereEnum_Or, fn)
+}
+
+// Not groups the conditions added by fn, which must not all hold.
+func (b *WhereBuilder[TEntity]) Not(fn WhereFn[TEntity]) *WhereBuilder[TEntity] {
+	return b.addGroup(WhereEnum_Not, fn)
+}
+
+func (b *WhereBuilder[TEntity]) addGroup(operator WhereEnum, fn WhereFn[TEntity]) *WhereBuilder[TEntity] {
+	group := &WhereBuilder[TEntity]{builderBase: b.builderBase, PointerMap: make(WherePointerMap)}
+	fn(group.Entity(), group)
+	if len(group.PointerMap) != 0 {
+		addWhereGroup(b.PointerMap, operator, group.PointerMap)
+	}
+	return b
+}
+
 func (b *WhereBuilder[TEntity]) ToJSON() *WhereBuilder[json.RawMessage] {
 	if b == nil {
 		return nil
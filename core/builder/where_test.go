@@ -0,0 +1,164 @@
+package builder
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type whereTestAmount struct {
+	Amount       int64  `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+func (a whereTestAmount) WhereMember() string {
+	return "amount"
+}
+
+func (a whereTestAmount) WhereValue() any {
+	return a.Amount
+}
+
+func (a whereTestAmount) WhereScope() map[string]any {
+	return map[string]any{"currency_code": a.CurrencyCode}
+}
+
+type whereTestEntity struct {
+	Status   string          `json:"status"`
+	Plan     string          `json:"plan"`
+	Tags     []string        `json:"tags"`
+	Metadata map[string]any  `json:"metadata"`
+	Total    whereTestAmount `json:"total"`
+}
+
+func TestWhereBuilder(t *testing.T) {
+	usd := func(amount int64) whereTestAmount { return whereTestAmount{Amount: amount, CurrencyCode: "USD"} }
+	eur := func(amount int64) whereTestAmount { return whereTestAmount{Amount: amount, CurrencyCode: "EUR"} }
+
+	tests := []struct {
+		name  string
+		where WhereFn[whereTestEntity]
+		json  string
+	}{
+		{
+			name: "conditions",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Equal(&e.Status, "ACTIVE").In(&e.Plan, []string{"PRO", "TEAM"})
+			},
+			json: `{"plan":{"in":["PRO","TEAM"]},"status":{"eq":"ACTIVE"}}`,
+		},
+		{
+			name: "same operator twice",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.NotEqual(&e.Status, "DELETED").NotEqual(&e.Status, "SUSPENDED")
+			},
+			json: `{"$1":{"and":{"status":{"neq":"SUSPENDED"}}},"status":{"neq":"DELETED"}}`,
+		},
+		{
+			name: "or keeps every alternative",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Or(func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+					w.Equal(&e.Status, "A").Equal(&e.Status, "B").Equal(&e.Status, "C")
+				})
+			},
+			json: `{"$1":{"or":{"$1":{"and":{"status":{"eq":"B"}}},"$2":{"and":{"status":{"eq":"C"}}},"status":{"eq":"A"}}}}`,
+		},
+		{
+			name: "or of and groups",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Or(func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+					w.And(func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+						w.Equal(&e.Status, "TRIAL").Equal(&e.Plan, "PRO")
+					}).Equal(&e.Status, "ACTIVE")
+				})
+			},
+			json: `{"$1":{"or":{"$1":{"and":{"plan":{"eq":"PRO"},"status":{"eq":"TRIAL"}}},"status":{"eq":"ACTIVE"}}}}`,
+		},
+		{
+			name: "not",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Not(func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+					w.Equal(&e.Status, "ACTIVE").Equal(&e.Plan, "FREE")
+				})
+			},
+			json: `{"$1":{"not":{"plan":{"eq":"FREE"},"status":{"eq":"ACTIVE"}}}}`,
+		},
+		{
+			name: "empty group is dropped",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Or(func(*whereTestEntity, *WhereBuilder[whereTestEntity]) {})
+			},
+			json: `{}`,
+		},
+		{
+			name: "dotted path",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Equal(w.Path(&e.Metadata, "plan", "tier"), "pro")
+			},
+			json: `{"metadata.plan.tier":{"eq":"pro"}}`,
+		},
+		{
+			name: "containment",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.Contains(&e.Metadata, map[string]any{"plan": "pro"}).ArrayContains(&e.Tags, "beta")
+			},
+			json: `{"metadata":{"contains":{"plan":"pro"}},"tags":{"acontains":"beta"}}`,
+		},
+		{
+			name: "scoped operand",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.GreaterEqual(&e.Total, usd(100))
+			},
+			json: `{"$1":{"and":{"total.amount":{"gte":100},"total.currency_code":{"eq":"USD"}}}}`,
+		},
+		{
+			name: "negated scoped operand keeps its scope",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.NotEqual(&e.Total, usd(100))
+			},
+			json: `{"$1":{"and":{"total.amount":{"neq":100},"total.currency_code":{"eq":"USD"}}}}`,
+		},
+		{
+			name: "scoped operands of several scopes",
+			where: func(e *whereTestEntity, w *WhereBuilder[whereTestEntity]) {
+				w.In(&e.Total, []whereTestAmount{usd(100), eur(200), usd(300)})
+			},
+			json: `{"$1":{"or":{` +
+				`"$1":{"and":{"total.amount":{"in":[100,300]},"total.currency_code":{"eq":"USD"}}},` +
+				`"$2":{"and":{"total.amount":{"in":[200]},"total.currency_code":{"eq":"EUR"}}}}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			where := NewWhere[whereTestEntity]()
+			test.where(where.Entity(), where)
+
+			encoded, err := json.Marshal(where.PointerMap)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(encoded) != test.json {
+				t.Fatalf("got  %s\nwant %s", encoded, test.json)
+			}
+		})
+	}
+}
+
+func TestAsWherePointerMap(t *testing.T) {
+	var decoded WherePointerMap
+	if err := json.Unmarshal([]byte(`{"$1":{"or":{"status":{"eq":"A"},"plan":{"eq":"PRO"}}}}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := AsWherePointerMap(decoded["$1"][WhereEnum_Or])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group["status"][WhereEnum_Equal] != "A" || group["plan"][WhereEnum_Equal] != "PRO" {
+		t.Fatalf("got %v", group)
+	}
+
+	if _, err := AsWherePointerMap("status"); err == nil {
+		t.Fatal("a string was accepted as a condition group")
+	}
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RequestInfo describes who is executing the current request. It is attached
+// to the context by the transport layer and filled in by authentication.
+type RequestInfo struct {
+	AccountID  *uuid.UUID
+	SessionKey string
+	IP         string
+	UserAgent  string
+
+	// TenantID is the tenant the caller acts on, only set once the caller is
+	// known to belong to it.
+	TenantID *uuid.UUID
+
+	// AccessTokenID is set when the caller used a personal access token or a
+	// tenant API key instead of a session; Scopes then restrict what it may call.
+	AccessTokenID *uuid.UUID
+	Scopes        []string
+
+	// ImpersonatorAccountID is set when a platform admin acts as AccountID
+	// through an impersonation token; SessionKey is then the impersonation id.
+	ImpersonatorAccountID *uuid.UUID
+}
+
+type requestInfoKey struct{}
+
+func WithRequestInfo(ctx context.Context, info *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// GetRequestInfo returns the request info stored in ctx, or an empty one for
+// calls that did not come from a transport (startup seeding, workers, ...).
+func GetRequestInfo(ctx context.Context) *RequestInfo {
+	if info, ok := ctx.Value(requestInfoKey{}).(*RequestInfo); ok && info != nil {
+		return info
+	}
+	return &RequestInfo{}
+}
@@ -6,3 +6,17 @@ type IUnitOfWork interface {
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
 }
+
+type unitOfWorkKey struct{}
+
+// WithUnitOfWork attaches uow to ctx; database calls made with the returned
+// context join it unless they are given another one.
+func WithUnitOfWork(ctx context.Context, uow IUnitOfWork) context.Context {
+	return context.WithValue(ctx, unitOfWorkKey{}, uow)
+}
+
+// GetUnitOfWork returns the unit of work stored in ctx, or nil.
+func GetUnitOfWork(ctx context.Context) IUnitOfWork {
+	uow, _ := ctx.Value(unitOfWorkKey{}).(IUnitOfWork)
+	return uow
+}
@@ -0,0 +1,91 @@
+package cqrs
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"src/core/validator"
+)
+
+// Next runs the rest of the pipeline: the following behavior or, at the end,
+// the handler.
+type Next func(ctx context.Context) (any, error)
+
+// Behavior wraps the execution of a command or query. It may act before and
+// after calling next, return without calling it to short-circuit, or return
+// a different result. A result must stay compatible with the one expected
+// by the caller.
+type Behavior func(ctx context.Context, message any, next Next) (any, error)
+
+type behaviorChain struct {
+	mutex  sync.RWMutex
+	global []Behavior
+	typed  map[reflect.Type][]Behavior
+}
+
+func newBehaviorChain() *behaviorChain {
+	return &behaviorChain{typed: map[reflect.Type][]Behavior{}}
+}
+
+func (c *behaviorChain) add(messageType reflect.Type, behavior Behavior) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if messageType == nil {
+		c.global = append(c.global, behavior)
+		return
+	}
+	c.typed[messageType] = append(c.typed[messageType], behavior)
+}
+
+// run executes handle behind the behaviors of messageType: global ones
+// first, then the ones registered for the type, each in registration order.
+func (c *behaviorChain) run(ctx context.Context, messageType reflect.Type, message any, handle Next) (any, error) {
+	c.mutex.RLock()
+	behaviors := make([]Behavior, 0, len(c.global)+len(c.typed[messageType]))
+	behaviors = append(behaviors, c.global...)
+	behaviors = append(behaviors, c.typed[messageType]...)
+	c.mutex.RUnlock()
+
+	next := handle
+	for index := len(behaviors) - 1; index >= 0; index-- {
+		behavior, inner := behaviors[index], next
+		next = func(ctx context.Context) (any, error) {
+			return behavior(ctx, message, inner)
+		}
+	}
+	return next(ctx)
+}
+
+// RegisterCommandBehavior wraps every command execution.
+func RegisterCommandBehavior(behavior Behavior) {
+	commandRegistry.behaviors.add(nil, behavior)
+}
+
+// RegisterCommandBehaviorFor wraps the executions of one command type, inside
+// the global command behaviors.
+func RegisterCommandBehaviorFor[TCommand any](behavior Behavior) {
+	commandRegistry.behaviors.add(normalizeType(reflect.TypeFor[TCommand]()), behavior)
+}
+
+// RegisterQueryBehavior wraps every query execution.
+func RegisterQueryBehavior(behavior Behavior) {
+	queryRegistry.behaviors.add(nil, behavior)
+}
+
+// RegisterQueryBehaviorFor wraps the executions of one query type, inside
+// the global query behaviors.
+func RegisterQueryBehaviorFor[TQuery any](behavior Behavior) {
+	queryRegistry.behaviors.add(normalizeType(reflect.TypeFor[TQuery]()), behavior)
+}
+
+// ValidationBehavior validates messages implementing validator.IValidable
+// before their handler runs.
+func ValidationBehavior(ctx context.Context, message any, next Next) (any, error) {
+	if validable, ok := message.(validator.IValidable); ok {
+		if err := validable.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
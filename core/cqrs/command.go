@@ -4,6 +4,13 @@ import "context"
 
 type Command any
 
+// ITransactional is implemented by commands whose handler must run in a
+// single unit of work. The transaction behavior stores it in the context,
+// where the database adapter picks it up.
+type ITransactional interface {
+	Transactional()
+}
+
 type ICommandHandler[TCommand any, TResult any] interface {
 	Handle(ctx context.Context, command TCommand) (TResult, error)
 }
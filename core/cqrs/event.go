@@ -0,0 +1,333 @@
+package cqrs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/di"
+)
+
+type Event any
+
+// IEventHandler reacts to one event type. Any number of handlers may be
+// registered for the same type.
+type IEventHandler[TEvent any] interface {
+	Handle(ctx context.Context, event TEvent) error
+}
+
+// INamedEvent gives an event its envelope type; other events are named after
+// their Go type.
+type INamedEvent interface {
+	EventName() string
+}
+
+// IVersionedEvent gives an event the version of its payload; other events
+// are version 1.
+type IVersionedEvent interface {
+	EventVersion() int
+}
+
+// EventEnvelope is the JSON form in which an event reaches asynchronous
+// handlers.
+type EventEnvelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// IEventTransport carries envelopes from PublishEvent to the asynchronous
+// handlers, usually through a stream shared by every replica. Publish gets
+// the context of PublishEvent, which may carry the unit of work of the
+// change that raised the event.
+type IEventTransport interface {
+	Publish(ctx context.Context, envelope EventEnvelope) error
+	// Subscribe calls deliver for every envelope received until ctx is done.
+	// An envelope whose deliver failed is delivered again, to this replica
+	// or another one.
+	Subscribe(ctx context.Context, deliver func(ctx context.Context, envelope EventEnvelope) error) error
+}
+
+// IEventInbox records the envelopes each asynchronous handler processed, so
+// one delivered again is not handled twice by the same consumer.
+type IEventInbox interface {
+	// Process runs handle unless consumer already processed messageID. The
+	// record is committed in the unit of work handle gets through its
+	// context, together with the changes it makes.
+	Process(ctx context.Context, consumer string, messageID string, handle func(ctx context.Context) error) error
+}
+
+type eventHandlerFN func(ctx context.Context, event any) error
+
+type eventHandler struct {
+	consumer string // handler type, which names the consumer in the inbox
+	handle   eventHandlerFN
+}
+
+type eventRegistry struct {
+	mutex sync.RWMutex
+	sync  map[reflect.Type][]eventHandler
+	async map[reflect.Type][]eventHandler
+	types map[string]reflect.Type // envelope type -> event type, for async delivery
+}
+
+var eventsRegistry = &eventRegistry{
+	sync:  map[reflect.Type][]eventHandler{},
+	async: map[reflect.Type][]eventHandler{},
+	types: map[string]reflect.Type{},
+}
+
+// RegisterEventHandler adds a handler that runs in-process, within
+// PublishEvent.
+func RegisterEventHandler[TEvent any, THandler IEventHandler[TEvent]](factoryFunction any) {
+	registerEventHandler[TEvent, THandler](eventsRegistry.sync, factoryFunction)
+}
+
+// RegisterAsyncEventHandler adds a handler that runs when the event comes
+// back through the IEventTransport, see ConsumeEvents. Each handler processes
+// an envelope at most once, through the IEventInbox.
+func RegisterAsyncEventHandler[TEvent any, THandler IEventHandler[TEvent]](factoryFunction any) {
+	registerEventHandler[TEvent, THandler](eventsRegistry.async, factoryFunction)
+}
+
+func registerEventHandler[TEvent any, THandler IEventHandler[TEvent]](handlers map[reflect.Type][]eventHandler, factoryFunction any) {
+	di.RegisterAs[THandler](factoryFunction)
+
+	eventType := normalizeType(reflect.TypeFor[TEvent]())
+	name := eventName(reflect.New(eventType).Elem().Interface())
+
+	eventsRegistry.mutex.Lock()
+	defer eventsRegistry.mutex.Unlock()
+
+	if known, exists := eventsRegistry.types[name]; exists && known != eventType {
+		panic(fmt.Sprintf("cqrs: event type %q already used by %v", name, known))
+	}
+	eventsRegistry.types[name] = eventType
+	handlers[eventType] = append(handlers[eventType], eventHandler{
+		consumer: reflect.TypeFor[THandler]().String(),
+		handle: func(ctx context.Context, event any) error {
+			typedEvent, err := coerce[TEvent](event, "event")
+			if err != nil {
+				return err
+			}
+			return di.ResolveFrom[THandler](ctx).Handle(ctx, typedEvent)
+		},
+	})
+}
+
+// PublishEvent runs the in-process handlers of each event and hands it to
+// the transport for the asynchronous ones. Inside a context holding an event
+// queue the in-process handlers wait in the queue until it is released, while
+// the transport still gets the event right away, with the context, so it can
+// write it in the same unit of work as the change.
+// Every handler runs even if another fails; their errors are joined.
+func PublishEvent(ctx context.Context, published ...Event) error {
+	return dispatchEvents(ctx, published, true)
+}
+
+func dispatchEvents(ctx context.Context, published []Event, withAsync bool) error {
+	queue, _ := ctx.Value(eventQueueKey{}).(*EventQueue)
+
+	var errs []error
+	for _, event := range published {
+		eventType, err := normalizedTypeKeyOfValue(event, "event")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		eventsRegistry.mutex.RLock()
+		syncHandlers, hasAsync := eventsRegistry.sync[eventType], len(eventsRegistry.async[eventType]) > 0
+		eventsRegistry.mutex.RUnlock()
+
+		if withAsync && hasAsync {
+			if err := publishEnvelope(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("cqrs: publish %s: %w", eventName(event), err))
+			}
+		}
+		if len(syncHandlers) == 0 {
+			continue
+		}
+		if queue != nil {
+			queue.push(event)
+			continue
+		}
+		for _, handler := range syncHandlers {
+			if err := handler.handle(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("cqrs: %s handler: %w", eventName(event), err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func publishEnvelope(ctx context.Context, event Event) error {
+	envelope, err := NewEventEnvelope(event)
+	if err != nil {
+		return err
+	}
+	return di.Resolve[IEventTransport]().Publish(ctx, envelope)
+}
+
+// NewEventEnvelope wraps event with a new time-ordered id.
+func NewEventEnvelope(event Event) (EventEnvelope, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return EventEnvelope{}, err
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return EventEnvelope{}, err
+	}
+
+	version := 1
+	if versioned, ok := event.(IVersionedEvent); ok {
+		version = versioned.EventVersion()
+	}
+	return EventEnvelope{
+		ID:         id.String(),
+		Type:       eventName(event),
+		Version:    version,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}, nil
+}
+
+// DispatchEnvelope runs the asynchronous handlers of a received envelope,
+// each through the IEventInbox so a handler that already processed it is
+// skipped. Envelopes of types without asynchronous handlers are ignored.
+func DispatchEnvelope(ctx context.Context, envelope EventEnvelope) error {
+	eventsRegistry.mutex.RLock()
+	eventType, known := eventsRegistry.types[envelope.Type]
+	handlers := eventsRegistry.async[eventType]
+	eventsRegistry.mutex.RUnlock()
+
+	if !known || len(handlers) == 0 {
+		return nil
+	}
+
+	event := reflect.New(eventType)
+	if err := json.Unmarshal(envelope.Data, event.Interface()); err != nil {
+		return fmt.Errorf("cqrs: decode %s %s: %w", envelope.Type, envelope.ID, err)
+	}
+
+	inbox := di.Resolve[IEventInbox]()
+
+	var errs []error
+	for _, handler := range handlers {
+		err := inbox.Process(ctx, handler.consumer, envelope.ID, func(ctx context.Context) error {
+			return handler.handle(ctx, event.Elem().Interface())
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cqrs: %s handler: %w", envelope.Type, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ConsumeEvents subscribes to the transport and dispatches what it receives
+// to the asynchronous handlers, until ctx is done. It then waits for the
+// envelopes being handled, which are not cancelled with ctx, and returns.
+// Without asynchronous handlers the transport is never needed.
+func ConsumeEvents(ctx context.Context) error {
+	eventsRegistry.mutex.RLock()
+	hasAsync := len(eventsRegistry.async) > 0
+	eventsRegistry.mutex.RUnlock()
+
+	if !hasAsync {
+		<-ctx.Done()
+		return nil
+	}
+
+	consumer := &eventConsumer{}
+	err := di.Resolve[IEventTransport]().Subscribe(ctx, func(deliverCtx context.Context, envelope EventEnvelope) error {
+		if !consumer.begin() {
+			return fmt.Errorf("cqrs: consumer stopped before %s %s", envelope.Type, envelope.ID)
+		}
+		defer consumer.inFlight.Done()
+		return DispatchEnvelope(context.WithoutCancel(deliverCtx), envelope)
+	})
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	consumer.stop()
+	return nil
+}
+
+// eventConsumer counts the envelopes being handled; once stopped it refuses
+// new ones, which the transport then delivers again later.
+type eventConsumer struct {
+	mutex    sync.Mutex
+	stopped  bool
+	inFlight sync.WaitGroup
+}
+
+func (c *eventConsumer) begin() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.stopped {
+		return false
+	}
+	c.inFlight.Add(1)
+	return true
+}
+
+func (c *eventConsumer) stop() {
+	c.mutex.Lock()
+	c.stopped = true
+	c.mutex.Unlock()
+	c.inFlight.Wait()
+}
+
+func eventName(event any) string {
+	if named, ok := event.(INamedEvent); ok {
+		return named.EventName()
+	}
+	return normalizeType(reflect.TypeOf(event)).String()
+}
+
+type eventQueueKey struct{}
+
+// EventQueue holds the events published while a unit of work is open, so
+// no in-process handler sees changes that may still roll back.
+type EventQueue struct {
+	mutex  sync.Mutex
+	queued []Event
+}
+
+// WithEventQueue returns a context in which PublishEvent queues the events
+// for their in-process handlers.
+func WithEventQueue(ctx context.Context) (context.Context, *EventQueue) {
+	queue := &EventQueue{}
+	return context.WithValue(ctx, eventQueueKey{}, queue), queue
+}
+
+func (q *EventQueue) push(event Event) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.queued = append(q.queued, event)
+}
+
+// Release runs the in-process handlers of the queued events with ctx, which
+// may itself queue them for an outer unit of work, and empties the queue.
+func (q *EventQueue) Release(ctx context.Context) error {
+	q.mutex.Lock()
+	queued := q.queued
+	q.queued = nil
+	q.mutex.Unlock()
+
+	if len(queued) == 0 {
+		return nil
+	}
+	return dispatchEvents(ctx, queued, false)
+}
@@ -0,0 +1,35 @@
+package cqrs
+
+import "context"
+
+// CommandGuard runs before a command's handler. An error refuses the command:
+// the handler is skipped and the error is returned in its place.
+type CommandGuard func(ctx context.Context, command Command) error
+
+// CommandHook observes a command after its handler returned. result is nil
+// when the handler failed or returned no result.
+type CommandHook func(ctx context.Context, command Command, result any, err error)
+
+// RegisterCommandGuard adds guard to the command pipeline as a behavior.
+func RegisterCommandGuard(guard CommandGuard) {
+	RegisterCommandBehavior(func(ctx context.Context, command any, next Next) (any, error) {
+		if err := guard(ctx, command); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	})
+}
+
+// RegisterCommandHook adds hook to the command pipeline as a behavior; it
+// sees what the behaviors registered after it returned.
+func RegisterCommandHook(hook CommandHook) {
+	RegisterCommandBehavior(func(ctx context.Context, command any, next Next) (any, error) {
+		result, err := next(ctx)
+		if err != nil {
+			hook(ctx, command, nil, err)
+		} else {
+			hook(ctx, command, result, nil)
+		}
+		return result, err
+	})
+}
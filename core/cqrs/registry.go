@@ -2,6 +2,7 @@ package cqrs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -12,12 +13,14 @@ import (
 type handlerRegistry struct {
 	mutex     sync.RWMutex
 	executors map[reflect.Type]func(ctx context.Context, message any) (any, error)
+	behaviors *behaviorChain
 	kindName  string
 }
 
 func newHandlerRegistry(kindName string) *handlerRegistry {
 	return &handlerRegistry{
 		executors: map[reflect.Type]func(context.Context, any) (any, error){},
+		behaviors: newBehaviorChain(),
 		kindName:  kindName,
 	}
 }
@@ -36,14 +39,28 @@ func register[TMessage any, TResult any, THandler interface {
 		panic(fmt.Sprintf("cqrs: %s handler already registered for type %v (normalized)", registry.kindName, messageKey))
 	}
 
-	registry.executors[messageKey] = func(ctx context.Context, message any) (any, error) {
+	registry.executors[messageKey] = func(ctx context.Context, message any) (result any, err error) {
 		typedMessage, err := coerce[TMessage](message, registry.kindName)
 		if err != nil {
 			return nil, err
 		}
 
-		handler := di.Resolve[THandler]()
-		return handler.Handle(ctx, typedMessage)
+		// every execution, nested ones included, gets its own scope
+		ctx, scope := di.WithScope(ctx)
+		defer func() {
+			if closeErr := scope.Close(); closeErr != nil {
+				result, err = nil, errors.Join(err, closeErr)
+			}
+		}()
+
+		return registry.behaviors.run(ctx, messageKey, typedMessage, func(ctx context.Context) (any, error) {
+			handler := di.ResolveFrom[THandler](ctx)
+			result, err := handler.Handle(ctx, typedMessage)
+			if isNil(result) { // keep a nil *T from becoming a non-nil interface
+				return nil, err
+			}
+			return result, err
+		})
 	}
 }
 
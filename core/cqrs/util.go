@@ -3,6 +3,7 @@ package cqrs
 import (
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 func normalizeType(t reflect.Type) reflect.Type {
@@ -87,3 +88,31 @@ func coerce[TExpected any](value any, valueName string) (TExpected, error) {
 
 	return zero, fmt.Errorf("cqrs: expected %s compatible with %v, got %T", valueName, expectedType, value)
 }
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return reflected.IsNil()
+	}
+	return false
+}
+
+// UseCaseName names a command or query after its use case package, e.g.
+// "src/application/usecase/currency/command/seed_currency" -> "currency.seed_currency".
+// Messages declared elsewhere keep their Go type name.
+func UseCaseName(message any) string {
+	messageType := reflect.TypeOf(message)
+	for messageType.Kind() == reflect.Pointer {
+		messageType = messageType.Elem()
+	}
+
+	segments := strings.Split(messageType.PkgPath(), "/")
+	if len(segments) >= 3 && (segments[len(segments)-2] == "command" || segments[len(segments)-2] == "query") {
+		return segments[len(segments)-3] + "." + segments[len(segments)-1]
+	}
+	return messageType.String()
+}
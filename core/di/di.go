@@ -1,11 +1,34 @@
 package di
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
 )
 
+type LifetimeEnum int
+
+const (
+	// Lifetime_Transient builds a new instance on every resolution.
+	Lifetime_Transient LifetimeEnum = iota
+	// Lifetime_Singleton builds one instance for the whole container.
+	Lifetime_Singleton
+	// Lifetime_Scoped builds one instance per scope, see WithScope.
+	Lifetime_Scoped
+)
+
+func (l LifetimeEnum) String() string {
+	switch l {
+	case Lifetime_Singleton:
+		return "singleton"
+	case Lifetime_Scoped:
+		return "scoped"
+	default:
+		return "transient"
+	}
+}
+
 var (
 	registryMutex    sync.RWMutex
 	providerRegistry = map[reflect.Type][]*Provider{}
@@ -14,8 +37,8 @@ var (
 type Provider struct {
 	FactoryFunction reflect.Value
 	OutputType      reflect.Type
-	IsSingleton     bool
-	CachedInstance  reflect.Value
+	Lifetime        LifetimeEnum
+	Key             string // empty for the default provider of OutputType
 }
 
 func typeOf[T any]() reflect.Type {
@@ -23,7 +46,7 @@ func typeOf[T any]() reflect.Type {
 	return reflect.TypeOf(zeroValue).Elem()
 }
 
-func registerProvider(factoryFunction any, isSingleton bool, asType reflect.Type) {
+func newProvider(factoryFunction any, lifetime LifetimeEnum, asType reflect.Type, key string) *Provider {
 	if factoryFunction == nil {
 		panic("di: nil factory function provided")
 	}
@@ -51,94 +74,167 @@ func registerProvider(factoryFunction any, isSingleton bool, asType reflect.Type
 		outputType = asType
 	}
 
-	providerInstance := &Provider{
+	return &Provider{
 		FactoryFunction: factoryValue,
 		OutputType:      outputType,
-		IsSingleton:     isSingleton,
+		Lifetime:        lifetime,
+		Key:             key,
 	}
+}
+
+func registerProvider(factoryFunction any, lifetime LifetimeEnum, asType reflect.Type) {
+	providerInstance := newProvider(factoryFunction, lifetime, asType, "")
 
 	registryMutex.Lock()
-	providerRegistry[outputType] = append(providerRegistry[outputType], providerInstance)
+	providerRegistry[providerInstance.OutputType] = append(providerRegistry[providerInstance.OutputType], providerInstance)
 	registryMutex.Unlock()
 }
 
-func resolveByType(targetType reflect.Type) reflect.Value {
-	registryMutex.RLock()
-	providers := providerRegistry[targetType]
-	registryMutex.RUnlock()
-
-	if len(providers) == 0 {
-		panic(fmt.Sprintf("di: no provider registered for type %v", targetType))
+func registerKeyedProvider(factoryFunction any, lifetime LifetimeEnum, asType reflect.Type, key string) {
+	if key == "" {
+		panic(fmt.Sprintf("di: empty key for %v", asType))
 	}
+	providerInstance := newProvider(factoryFunction, lifetime, asType, key)
 
-	return buildInstance(providers[0])
-}
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
 
-func buildInstance(providerInstance *Provider) reflect.Value {
-	if providerInstance.IsSingleton {
-		registryMutex.Lock()
-		if providerInstance.CachedInstance.IsValid() {
-			instance := providerInstance.CachedInstance
-			registryMutex.Unlock()
-			return instance
-		}
+	if findProvider(providerRegistry[asType], key) != nil {
+		panic(fmt.Sprintf("di: %v already registered with key %q, use ReplaceKeyed to override it", asType, key))
+	}
+	providerRegistry[asType] = append(providerRegistry[asType], providerInstance)
+}
 
-		instance := callFactoryWithDependencies(providerInstance)
-		providerInstance.CachedInstance = instance
-		registryMutex.Unlock()
+// replaceProvider swaps the provider of asType under key for one built by
+// factoryFunction, keeping its lifetime. The slice is copied, since readers
+// may hold the previous one.
+func replaceProvider(factoryFunction any, asType reflect.Type, key string) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
 
-		return instance
+	providers := providerRegistry[asType]
+	for index, current := range providers {
+		if current.Key != key {
+			continue
+		}
+		replaced := append([]*Provider(nil), providers...)
+		replaced[index] = newProvider(factoryFunction, current.Lifetime, asType, key)
+		providerRegistry[asType] = replaced
+		return
 	}
-
-	return callFactoryWithDependencies(providerInstance)
+	panic(fmt.Sprintf("di: nothing to replace for %s", describe(asType, key)))
 }
 
-func callFactoryWithDependencies(providerInstance *Provider) reflect.Value {
-	factoryType := providerInstance.FactoryFunction.Type()
-	numberOfInputs := factoryType.NumIn()
-
-	arguments := make([]reflect.Value, numberOfInputs)
+func providersOf(targetType reflect.Type) []*Provider {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	return providerRegistry[targetType]
+}
 
-	for index := 0; index < numberOfInputs; index++ {
-		dependencyType := factoryType.In(index)
-		arguments[index] = resolveByType(dependencyType)
+// findProvider returns the provider registered under key, the first default
+// one for an empty key.
+func findProvider(providers []*Provider, key string) *Provider {
+	for _, providerInstance := range providers {
+		if providerInstance.Key == key {
+			return providerInstance
+		}
 	}
+	return nil
+}
 
-	outputValues := providerInstance.FactoryFunction.Call(arguments)
-	return outputValues[0]
+func describe(targetType reflect.Type, key string) string {
+	if key == "" {
+		return targetType.String()
+	}
+	return fmt.Sprintf("%v[%s]", targetType, key)
 }
 
 func Register(factoryFunction any) {
-	registerProvider(factoryFunction, false, nil)
+	registerProvider(factoryFunction, Lifetime_Transient, nil)
 }
 
 func RegisterAs[TType any](factoryFunction any) {
 	targetType := typeOf[TType]()
-	registerProvider(factoryFunction, false, targetType)
+	registerProvider(factoryFunction, Lifetime_Transient, targetType)
 }
 
 func Singleton(factoryFunction any) {
-	registerProvider(factoryFunction, true, nil)
+	registerProvider(factoryFunction, Lifetime_Singleton, nil)
 }
 
 func SingletonAs[TType any](factoryFunction any) {
 	targetType := typeOf[TType]()
-	registerProvider(factoryFunction, true, targetType)
+	registerProvider(factoryFunction, Lifetime_Singleton, targetType)
+}
+
+func Scoped(factoryFunction any) {
+	registerProvider(factoryFunction, Lifetime_Scoped, nil)
+}
+
+func ScopedAs[TType any](factoryFunction any) {
+	targetType := typeOf[TType]()
+	registerProvider(factoryFunction, Lifetime_Scoped, targetType)
+}
+
+// RegisterKeyed registers one of several implementations of TType, told
+// apart by key; see ResolveKeyed and Keyed. A key is registered once per
+// type.
+func RegisterKeyed[TType any](key string, factoryFunction any) {
+	registerKeyedProvider(factoryFunction, Lifetime_Transient, typeOf[TType](), key)
+}
+
+func SingletonKeyed[TType any](key string, factoryFunction any) {
+	registerKeyedProvider(factoryFunction, Lifetime_Singleton, typeOf[TType](), key)
+}
+
+func ScopedKeyed[TType any](key string, factoryFunction any) {
+	registerKeyedProvider(factoryFunction, Lifetime_Scoped, typeOf[TType](), key)
+}
+
+// Replace overrides the default provider of TType, keeping its lifetime;
+// tests use it to swap an implementation. It panics when TType has no
+// default provider, so a typo cannot go unnoticed. Instances already built
+// by the replaced provider are kept by their scopes.
+func Replace[TType any](factoryFunction any) {
+	replaceProvider(factoryFunction, typeOf[TType](), "")
 }
 
+// ReplaceKeyed overrides the provider of TType registered under key, as
+// Replace does.
+func ReplaceKeyed[TType any](key string, factoryFunction any) {
+	replaceProvider(factoryFunction, typeOf[TType](), key)
+}
+
+// Resolve builds T from the container itself; scoped providers cannot be
+// resolved this way, see ResolveFrom.
 func Resolve[T any]() T {
-	targetType := typeOf[T]()
-	value := resolveByType(targetType)
-	return value.Interface().(T)
+	return root.resolve(typeOf[T](), "", nil).Interface().(T)
+}
+
+// ResolveKeyed builds the implementation of T registered under key.
+func ResolveKeyed[T any](key string) T {
+	return root.resolve(typeOf[T](), key, nil).Interface().(T)
 }
 
+// ResolveAll builds every provider of T, keyed ones included.
 func ResolveAll[T any]() []T {
-	targetType := typeOf[T]()
+	return resolveAll[T](root)
+}
 
-	registryMutex.RLock()
-	providers := providerRegistry[targetType]
-	registryMutex.RUnlock()
+// ResolveFrom builds T in the scope carried by ctx, or in the container
+// itself when ctx carries none.
+func ResolveFrom[T any](ctx context.Context) T {
+	return scopeOf(ctx).resolve(typeOf[T](), "", nil).Interface().(T)
+}
+
+// ResolveKeyedFrom builds the implementation of T registered under key in
+// the scope carried by ctx.
+func ResolveKeyedFrom[T any](ctx context.Context, key string) T {
+	return scopeOf(ctx).resolve(typeOf[T](), key, nil).Interface().(T)
+}
 
+func resolveAll[T any](scope *Scope) []T {
+	providers := providersOf(typeOf[T]())
 	if len(providers) == 0 {
 		return nil
 	}
@@ -146,7 +242,7 @@ func ResolveAll[T any]() []T {
 	results := make([]T, 0, len(providers))
 
 	for _, providerInstance := range providers {
-		value := buildInstance(providerInstance)
+		value := scope.build(providerInstance, []dependency{{Type: typeOf[T](), Key: providerInstance.Key}})
 		results = append(results, value.Interface().(T))
 	}
 
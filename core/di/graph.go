@@ -0,0 +1,70 @@
+package di
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Graph is the dependency graph of the registered providers, for
+// documentation. Keyed providers appear as Type[key].
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+}
+
+type GraphNode struct {
+	Type         string   `json:"type"`
+	Lifetime     string   `json:"lifetime"`
+	Factory      string   `json:"factory"`
+	Dependencies []string `json:"dependencies"`
+}
+
+// DependencyGraph lists every provider with the types its factory depends
+// on; marshal it to JSON, or see DOT.
+func DependencyGraph() *Graph {
+	registry := snapshot()
+
+	graph := &Graph{Nodes: []GraphNode{}}
+	for _, outputType := range sortedTypes(registry) {
+		for _, providerInstance := range registry[outputType] {
+			node := GraphNode{
+				Type:         describe(outputType, providerInstance.Key),
+				Lifetime:     providerInstance.Lifetime.String(),
+				Dependencies: []string{},
+			}
+			if factory := runtime.FuncForPC(providerInstance.FactoryFunction.Pointer()); factory != nil {
+				node.Factory = factory.Name()
+			}
+			for _, next := range dependenciesOf(providerInstance) {
+				node.Dependencies = append(node.Dependencies, next.String())
+			}
+			graph.Nodes = append(graph.Nodes, node)
+		}
+	}
+	return graph
+}
+
+// DOT renders the graph in Graphviz DOT, one node per type and an edge from
+// each type to every type it depends on.
+func (g *Graph) DOT() string {
+	var builder strings.Builder
+	builder.WriteString("digraph di {\n")
+	builder.WriteString("\trankdir=LR;\n")
+	builder.WriteString("\tnode [shape=box];\n")
+
+	declared := map[string]bool{}
+	for _, node := range g.Nodes {
+		if !declared[node.Type] {
+			declared[node.Type] = true
+			fmt.Fprintf(&builder, "\t%q [label=%q];\n", node.Type, node.Type+"\n"+node.Lifetime)
+		}
+	}
+	for _, node := range g.Nodes {
+		for _, dependency := range node.Dependencies {
+			fmt.Fprintf(&builder, "\t%q -> %q;\n", node.Type, dependency)
+		}
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
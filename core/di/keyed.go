@@ -0,0 +1,41 @@
+package di
+
+import "reflect"
+
+// IKey names, by its type, the key of a Keyed factory parameter.
+type IKey interface {
+	Key() string
+}
+
+// Keyed is a factory parameter asking for the implementation of T registered
+// under the key of K:
+//
+//	type AvatarBucket struct{}
+//
+//	func (AvatarBucket) Key() string { return "avatar" }
+//
+//	func NewAvatarService(bucket di.Keyed[storage.IStorageAdapter, AvatarBucket]) *AvatarService
+type Keyed[T any, K IKey] struct {
+	Value T
+}
+
+type keyedParameter interface {
+	keyedTarget() (reflect.Type, string)
+}
+
+func (Keyed[T, K]) keyedTarget() (reflect.Type, string) {
+	var key K
+	return typeOf[T](), key.Key()
+}
+
+var keyedParameterType = reflect.TypeFor[keyedParameter]()
+
+// keyedTarget tells whether dependencyType is a Keyed parameter, and which
+// type and key it asks for.
+func keyedTarget(dependencyType reflect.Type) (reflect.Type, string, bool) {
+	if dependencyType.Kind() != reflect.Struct || !dependencyType.Implements(keyedParameterType) {
+		return nil, "", false
+	}
+	targetType, key := reflect.Zero(dependencyType).Interface().(keyedParameter).keyedTarget()
+	return targetType, key, true
+}
@@ -0,0 +1,206 @@
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+)
+
+// Scope owns the instances of scoped providers resolved in it, and disposes
+// them, together with the transient instances it built, when closed. The
+// container itself is the root scope, owning the singletons.
+type Scope struct {
+	mutex     sync.Mutex
+	instances map[*Provider]*instance
+	// instances to dispose, in the order their construction completed, so
+	// an instance always comes after its dependencies
+	disposables []any
+	closed      bool
+}
+
+// instance is built once per scope; the scope lock is not held while the
+// factory runs, so factories may resolve their own dependencies freely. A
+// factory that panics leaves the instance unbuilt, so the next resolution
+// runs it again.
+type instance struct {
+	mutex sync.Mutex
+	built bool
+	value reflect.Value
+}
+
+var root = newScope()
+
+func newScope() *Scope {
+	return &Scope{instances: map[*Provider]*instance{}}
+}
+
+type scopeKey struct{}
+
+// WithScope returns a context holding a new scope, closed by the caller once
+// the work it was made for is done.
+func WithScope(ctx context.Context) (context.Context, *Scope) {
+	scope := newScope()
+	return context.WithValue(ctx, scopeKey{}, scope), scope
+}
+
+func scopeOf(ctx context.Context) *Scope {
+	if scope, ok := ctx.Value(scopeKey{}).(*Scope); ok {
+		return scope
+	}
+	return root
+}
+
+// resolve builds target for the factories on path, which is how the
+// resolution got there; a target already on it is a cycle, reported the way
+// Validate does instead of waiting on its own construction forever.
+func (s *Scope) resolve(targetType reflect.Type, key string, path []dependency) reflect.Value {
+	target := dependency{Type: targetType, Key: key}
+	for index, onPath := range path {
+		if onPath == target {
+			panic(fmt.Sprintf("di: cycle %s", formatPath(append(path[index:], target))))
+		}
+	}
+
+	providerInstance := findProvider(providersOf(targetType), key)
+	if providerInstance == nil {
+		panic(fmt.Sprintf("di: no provider registered for type %s", describe(targetType, key)))
+	}
+
+	return s.build(providerInstance, append(path, target))
+}
+
+// resolveDependency builds a factory argument, unwrapping Keyed parameters.
+func (s *Scope) resolveDependency(dependencyType reflect.Type, path []dependency) reflect.Value {
+	targetType, key, keyed := keyedTarget(dependencyType)
+	if !keyed {
+		return s.resolve(dependencyType, "", path)
+	}
+	argument := reflect.New(dependencyType).Elem()
+	argument.Field(0).Set(s.resolve(targetType, key, path))
+	return argument
+}
+
+// build returns the instance of providerInstance, the last one on path.
+func (s *Scope) build(providerInstance *Provider, path []dependency) reflect.Value {
+	switch providerInstance.Lifetime {
+	case Lifetime_Singleton:
+		// dependencies of a singleton resolve in the container too, so it
+		// never holds on to a scoped instance
+		return root.cached(providerInstance, path)
+	case Lifetime_Scoped:
+		if s == root {
+			panic(fmt.Sprintf("di: scoped provider %v resolved outside a scope", providerInstance.OutputType))
+		}
+		return s.cached(providerInstance, path)
+	default:
+		return s.create(providerInstance, path)
+	}
+}
+
+func (s *Scope) cached(providerInstance *Provider, path []dependency) reflect.Value {
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		panic(fmt.Sprintf("di: %v resolved in a closed scope", providerInstance.OutputType))
+	}
+	slot, exists := s.instances[providerInstance]
+	if !exists {
+		slot = &instance{}
+		s.instances[providerInstance] = slot
+	}
+	s.mutex.Unlock()
+
+	slot.mutex.Lock()
+	defer slot.mutex.Unlock()
+	if !slot.built {
+		slot.value = s.create(providerInstance, path)
+		slot.built = true
+	}
+	return slot.value
+}
+
+func (s *Scope) create(providerInstance *Provider, path []dependency) reflect.Value {
+	factoryType := providerInstance.FactoryFunction.Type()
+	numberOfInputs := factoryType.NumIn()
+
+	arguments := make([]reflect.Value, numberOfInputs)
+
+	for index := 0; index < numberOfInputs; index++ {
+		dependencyType := factoryType.In(index)
+		arguments[index] = s.resolveDependency(dependencyType, path)
+	}
+
+	outputValues := providerInstance.FactoryFunction.Call(arguments)
+	value := outputValues[0]
+
+	// transients resolved from the container are left to the caller, the
+	// container would otherwise keep every one of them until shutdown
+	if s != root || providerInstance.Lifetime != Lifetime_Transient {
+		s.track(value)
+	}
+	return value
+}
+
+func (s *Scope) track(value reflect.Value) {
+	if !value.IsValid() || !value.CanInterface() {
+		return
+	}
+	switch value.Interface().(type) {
+	case io.Closer, interface{ Close() }:
+		s.mutex.Lock()
+		s.disposables = append(s.disposables, value.Interface())
+		s.mutex.Unlock()
+	}
+}
+
+// Close disposes the instances of the scope that implement io.Closer, or
+// have a Close method without result, in reverse creation order. Every one
+// is closed even if another fails; their errors are joined. Nothing can be
+// resolved in the scope afterwards.
+func (s *Scope) Close() error {
+	return s.close(nil)
+}
+
+func (s *Scope) close(report func(instance any, err error)) error {
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.closed = true
+	disposables := s.disposables
+	s.disposables = nil
+	s.mutex.Unlock()
+
+	var errs []error
+	for i := len(disposables) - 1; i >= 0; i-- {
+		var err error
+		switch closer := disposables[i].(type) {
+		case io.Closer:
+			if err = closer.Close(); err != nil {
+				err = fmt.Errorf("di: close %T: %w", closer, err)
+				errs = append(errs, err)
+			}
+		case interface{ Close() }:
+			closer.Close()
+		}
+		if report != nil {
+			report(disposables[i], err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close disposes the singletons of the container, see Scope.Close.
+func Close() error {
+	return root.close(nil)
+}
+
+// CloseWithReport disposes the singletons of the container as Close does,
+// calling report after each one.
+func CloseWithReport(report func(instance any, err error)) error {
+	return root.close(report)
+}
@@ -0,0 +1,51 @@
+package di
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type flakySingleton struct{}
+
+type cycleStart struct{}
+type cycleMiddle struct{}
+
+func recoverPanic(resolve func()) (failure any) {
+	defer func() { failure = recover() }()
+	resolve()
+	return nil
+}
+
+func TestSingletonFactoryPanicIsRetried(t *testing.T) {
+	calls := 0
+	Singleton(func() *flakySingleton {
+		calls++
+		if calls == 1 {
+			panic("not ready yet")
+		}
+		return &flakySingleton{}
+	})
+
+	if failure := recoverPanic(func() { Resolve[*flakySingleton]() }); failure != "not ready yet" {
+		t.Fatalf("first resolution panicked with %v, want the factory panic", failure)
+	}
+	first := Resolve[*flakySingleton]()
+	if first == nil || Resolve[*flakySingleton]() != first {
+		t.Fatal("the singleton was not built once after the failed attempt")
+	}
+	if calls != 2 {
+		t.Fatalf("factory ran %d times, want 2", calls)
+	}
+}
+
+func TestSingletonCyclePanics(t *testing.T) {
+	Singleton(func(*cycleMiddle) *cycleStart { return &cycleStart{} })
+	Register(func(*cycleStart) *cycleMiddle { return &cycleMiddle{} })
+
+	failure := recoverPanic(func() { Resolve[*cycleStart]() })
+	want := "di: cycle *di.cycleStart -> *di.cycleMiddle -> *di.cycleStart"
+	if message := fmt.Sprint(failure); !strings.Contains(message, want) {
+		t.Fatalf("got panic %q, want %q", message, want)
+	}
+}
@@ -0,0 +1,160 @@
+package di
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Validate walks the dependencies of every registered factory and reports,
+// at once, each missing provider and each cycle with the resolution path that
+// leads to it, as well as singletons depending on scoped providers. It builds
+// nothing, so it can run at startup before the first Resolve.
+func Validate() error {
+	registry := snapshot()
+
+	v := &validation{
+		registry: registry,
+		done:     map[dependency]bool{},
+		problems: map[string]bool{},
+	}
+	for _, outputType := range sortedTypes(registry) {
+		for _, providerInstance := range registry[outputType] {
+			v.visit(dependency{Type: outputType, Key: providerInstance.Key}, nil)
+		}
+	}
+
+	if len(v.problems) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(v.problems))
+	for message := range v.problems {
+		messages = append(messages, message)
+	}
+	sort.Strings(messages)
+
+	errs := make([]error, 0, len(messages))
+	for _, message := range messages {
+		errs = append(errs, errors.New(message))
+	}
+	return errors.Join(errs...)
+}
+
+type validation struct {
+	registry map[reflect.Type][]*Provider
+	done     map[dependency]bool
+	problems map[string]bool
+}
+
+// dependency is a type to resolve, under a key for Keyed parameters.
+type dependency struct {
+	Type reflect.Type
+	Key  string
+}
+
+func (d dependency) String() string {
+	return describe(d.Type, d.Key)
+}
+
+func (v *validation) visit(target dependency, path []dependency) {
+	for index, onPath := range path {
+		if onPath == target {
+			v.problems[fmt.Sprintf("di: cycle %s", formatPath(append(path[index:], target)))] = true
+			return
+		}
+	}
+	if v.done[target] {
+		return
+	}
+
+	var providers []*Provider
+	if findProvider(v.registry[target.Type], target.Key) != nil {
+		providers = []*Provider{findProvider(v.registry[target.Type], target.Key)}
+		if target.Key == "" {
+			// ResolveAll builds every provider of a type, so all of them are checked
+			providers = v.registry[target.Type]
+		}
+	}
+
+	path = append(path, target)
+	if len(providers) == 0 {
+		v.problems[fmt.Sprintf("di: no provider registered for type %v, needed by %s", target, formatPath(path[:len(path)-1]))] = true
+		v.done[target] = true
+		return
+	}
+
+	for _, providerInstance := range providers {
+		if providerInstance.Lifetime == Lifetime_Singleton {
+			v.checkSingleton(target, providerInstance, path, map[dependency]bool{})
+		}
+		for _, next := range dependenciesOf(providerInstance) {
+			v.visit(next, path)
+		}
+	}
+	v.done[target] = true
+}
+
+// checkSingleton reports the scoped providers a singleton depends on. The
+// transient providers it depends on are built in the container along with
+// it, so their own dependencies are followed too.
+func (v *validation) checkSingleton(singleton dependency, providerInstance *Provider, path []dependency, seen map[dependency]bool) {
+	for _, next := range dependenciesOf(providerInstance) {
+		resolved := findProvider(v.registry[next.Type], next.Key)
+		if resolved == nil || seen[next] {
+			continue
+		}
+		seen[next] = true
+		switch resolved.Lifetime {
+		case Lifetime_Scoped:
+			v.problems[fmt.Sprintf("di: singleton %v depends on scoped %v, path %s", singleton, next, formatPath(append(path, next)))] = true
+		case Lifetime_Transient:
+			v.checkSingleton(singleton, resolved, append(path, next), seen)
+		}
+	}
+}
+
+func formatPath(path []dependency) string {
+	names := make([]string, len(path))
+	for index, onPath := range path {
+		names[index] = onPath.String()
+	}
+	return strings.Join(names, " -> ")
+}
+
+func dependenciesOf(providerInstance *Provider) []dependency {
+	factoryType := providerInstance.FactoryFunction.Type()
+	dependencies := make([]dependency, factoryType.NumIn())
+	for index := range dependencies {
+		dependencyType := factoryType.In(index)
+		if targetType, key, keyed := keyedTarget(dependencyType); keyed {
+			dependencies[index] = dependency{Type: targetType, Key: key}
+			continue
+		}
+		dependencies[index] = dependency{Type: dependencyType}
+	}
+	return dependencies
+}
+
+func snapshot() map[reflect.Type][]*Provider {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	registry := make(map[reflect.Type][]*Provider, len(providerRegistry))
+	for outputType, providers := range providerRegistry {
+		registry[outputType] = append([]*Provider(nil), providers...)
+	}
+	return registry
+}
+
+// sortedTypes orders the registered types by name, so reports and graphs
+// come out the same on every run.
+func sortedTypes(registry map[reflect.Type][]*Provider) []reflect.Type {
+	types := make([]reflect.Type, 0, len(registry))
+	for outputType := range registry {
+		types = append(types, outputType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].String() < types[j].String() })
+	return types
+}
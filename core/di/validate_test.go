@@ -0,0 +1,22 @@
+package di
+
+import (
+	"strings"
+	"testing"
+)
+
+type requestState struct{}
+type requestReader struct{}
+type sharedCache struct{}
+
+func TestValidateFindsScopedBehindTransient(t *testing.T) {
+	Scoped(func() *requestState { return &requestState{} })
+	Register(func(*requestState) *requestReader { return &requestReader{} })
+	Singleton(func(*requestReader) *sharedCache { return &sharedCache{} })
+
+	err := Validate()
+	want := "di: singleton *di.sharedCache depends on scoped *di.requestState, path *di.sharedCache -> *di.requestReader -> *di.requestState"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("got %v, want a report containing %q", err, want)
+	}
+}
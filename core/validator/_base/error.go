@@ -9,9 +9,9 @@ type Error struct {
 }
 
 type ValidationError struct {
-	Field   string
-	Code    string
-	Message string
+	Field   string `json:"field,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 func (e *Error) Error() string {
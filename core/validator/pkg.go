@@ -20,6 +20,7 @@ type (
 	IRule           = _base.IRule
 	Error           = _base.Error
 	ValidationError = _base.ValidationError
+	PasswordPolicy  = string.PasswordPolicy
 )
 
 type (
@@ -14,6 +14,8 @@ type (
 	LengthRule     = rule.LengthRule
 	MaxRule        = rule.MaxRule
 	MinRule        = rule.MinRule
+	PasswordPolicy = rule.PasswordPolicy
+	PasswordRule   = rule.PasswordRule
 	RegexRule      = rule.RegexRule
 	ReplaceRule    = rule.ReplaceRule
 	TransformRule  = rule.TransformRule
@@ -0,0 +1,152 @@
+package rule
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"src/core/validator/_base"
+)
+
+// PasswordPolicy lists what a password must satisfy. Every failed check is
+// reported with its own code so a client can show them all at once.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	RequireLowercase bool
+	RequireUppercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+
+	// Personal values (email, name, ...) the password may not contain. An
+	// email only counts by its local part; words shorter than 4 are ignored.
+	Personal []string
+
+	// Breached reports a known leaked or common password. Nil skips the check.
+	Breached func(password string) bool
+}
+
+type PasswordRule struct {
+	Policy PasswordPolicy
+}
+
+var _ _base.IRule = PasswordRule{}
+
+func (r PasswordRule) Apply(valuePointer reflect.Value) []_base.ValidationError {
+	if !valuePointer.IsValid() {
+		return nil
+	}
+
+	target := valuePointer.Elem()
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			return nil
+		}
+		target = target.Elem()
+	}
+
+	if target.Kind() != reflect.String {
+		return []_base.ValidationError{{
+			Code:    "string.password",
+			Message: "string must be a string",
+		}}
+	}
+
+	value := target.String()
+	if value == "" {
+		return nil
+	}
+
+	var errors []_base.ValidationError
+	policy := r.Policy
+
+	length := utf8.RuneCountInString(value)
+	if policy.MinLength > 0 && length < policy.MinLength {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.min",
+			Message: fmt.Sprintf("password must have at least %d characters", policy.MinLength),
+		})
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.max",
+			Message: fmt.Sprintf("password must have at most %d characters", policy.MaxLength),
+		})
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, character := range value {
+		switch {
+		case unicode.IsLower(character):
+			hasLower = true
+		case unicode.IsUpper(character):
+			hasUpper = true
+		case unicode.IsDigit(character):
+			hasDigit = true
+		case !unicode.IsSpace(character):
+			hasSymbol = true
+		}
+	}
+	if policy.RequireLowercase && !hasLower {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.lowercase",
+			Message: "password must contain a lowercase letter",
+		})
+	}
+	if policy.RequireUppercase && !hasUpper {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.uppercase",
+			Message: "password must contain an uppercase letter",
+		})
+	}
+	if policy.RequireDigit && !hasDigit {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.digit",
+			Message: "password must contain a digit",
+		})
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.symbol",
+			Message: "password must contain a symbol",
+		})
+	}
+
+	if containsPersonal(value, policy.Personal) {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.personal",
+			Message: "password must not contain your email or name",
+		})
+	}
+
+	// a breached password is only looked up once the cheap checks pass
+	if len(errors) == 0 && policy.Breached != nil && policy.Breached(value) {
+		errors = append(errors, _base.ValidationError{
+			Code:    "string.password.breached",
+			Message: "password appears in a list of leaked or common passwords",
+		})
+	}
+
+	return errors
+}
+
+func containsPersonal(password string, personal []string) bool {
+	password = strings.ToLower(password)
+	for _, value := range personal {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if at := strings.LastIndex(value, "@"); at >= 0 {
+			value = value[:at]
+		}
+		words := strings.FieldsFunc(value, func(character rune) bool {
+			return !unicode.IsLetter(character) && !unicode.IsDigit(character)
+		})
+		for _, word := range append(words, value) {
+			if utf8.RuneCountInString(word) >= 4 && strings.Contains(password, word) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package rule
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestPasswordRule(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:        10,
+		MaxLength:        20,
+		RequireLowercase: true,
+		RequireUppercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+	breached := PasswordPolicy{
+		MinLength: 8,
+		Breached:  func(password string) bool { return password == "password123" },
+	}
+	personal := PasswordPolicy{
+		MinLength: 8,
+		Personal:  []string{"jane.doe@example.com", "Jane Doe"},
+	}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		codes    []string
+	}{
+		{name: "valid", policy: strict, password: "Correct-Horse-9"},
+		{name: "empty is left to Required", policy: strict, password: ""},
+		{name: "too short", policy: strict, password: "Sh0rt-pw", codes: []string{"string.password.min"}},
+		{name: "too long", policy: strict, password: "Correct-Horse-Battery-9", codes: []string{"string.password.max"}},
+		{name: "length counts runes", policy: PasswordPolicy{MinLength: 4}, password: "ééé", codes: []string{"string.password.min"}},
+		{name: "no lowercase", policy: strict, password: "CORRECT-HORSE-9", codes: []string{"string.password.lowercase"}},
+		{name: "no uppercase", policy: strict, password: "correct-horse-9", codes: []string{"string.password.uppercase"}},
+		{name: "no digit", policy: strict, password: "Correct-Horse-X", codes: []string{"string.password.digit"}},
+		{name: "no symbol", policy: strict, password: "CorrectHorse99", codes: []string{"string.password.symbol"}},
+		{
+			name:     "every failure at once",
+			policy:   strict,
+			password: "abc",
+			codes:    []string{"string.password.min", "string.password.uppercase", "string.password.digit", "string.password.symbol"},
+		},
+		{name: "email local part", policy: personal, password: "xx-jane.doe-xx", codes: []string{"string.password.personal"}},
+		{name: "name word", policy: personal, password: "iamjane-2024", codes: []string{"string.password.personal"}},
+		{name: "short words ignored", policy: PasswordPolicy{Personal: []string{"Al Bo"}}, password: "al-bo-secret"},
+		{name: "breached", policy: breached, password: "password123", codes: []string{"string.password.breached"}},
+		{name: "not breached", policy: breached, password: "password124"},
+		{name: "breached only after cheap checks", policy: breached, password: "pass", codes: []string{"string.password.min"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			password := test.password
+			errors := PasswordRule{Policy: test.policy}.Apply(reflect.ValueOf(&password))
+
+			var codes []string
+			for _, err := range errors {
+				codes = append(codes, err.Code)
+			}
+			if !slices.Equal(codes, test.codes) {
+				t.Fatalf("codes %v, want %v", codes, test.codes)
+			}
+		})
+	}
+}
+
+func TestPasswordRuleRejectsNonString(t *testing.T) {
+	value := 42
+	errors := PasswordRule{}.Apply(reflect.ValueOf(&value))
+	if len(errors) != 1 || errors[0].Code != "string.password" {
+		t.Fatalf("got %v, want a single string.password error", errors)
+	}
+}
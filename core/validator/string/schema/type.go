@@ -146,6 +146,10 @@ func (builder *StringSchema) Min(min int) *StringSchema {
 	builder.UnknownSchema.AddRule(rule.MinRule{Min: min})
 	return builder
 }
+func (builder *StringSchema) Password(policy rule.PasswordPolicy) *StringSchema {
+	builder.UnknownSchema.AddRule(rule.PasswordRule{Policy: policy})
+	return builder
+}
 func (builder *StringSchema) Pattern(pattern *regexp.Regexp) *StringSchema {
 	if pattern == nil {
 		return builder
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessTokenKindEnum string
+
+const (
+	AccessTokenKind_Personal AccessTokenKindEnum = "PERSONAL" // acts as the account that created it
+	AccessTokenKind_Tenant   AccessTokenKindEnum = "TENANT"   // an API key acting within one tenant
+)
+
+// AccessTokenEntity is a long-lived bearer credential for scripts and
+// integrations. Only the hash of the token is kept; Prefix is its public,
+// unique part, used to find it and to tell tokens apart in lists. Empty
+// Scopes grant everything the owner can do.
+type AccessTokenEntity struct {
+	ID         uuid.UUID  `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	Kind       string     `json:"kind"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	TokenHash  string     `json:"token_hash"`
+	Scopes     []string   `json:"scopes"`
+	AccountID  uuid.UUID  `json:"account_id"`
+	TenantID   *uuid.UUID `json:"tenant_id"`
+}
+
+func (e *AccessTokenEntity) MarshalJSON() ([]byte, error) {
+	type Alias AccessTokenEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *AccessTokenEntity) UnmarshalJSON(data []byte) error {
+	type Alias AccessTokenEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
@@ -31,3 +31,11 @@ func (e *AccountCertificateEntity) UnmarshalJSON(data []byte) error {
 	type Alias AccountCertificateEntity
 	return json.Unmarshal(data, (*Alias)(e))
 }
+
+// WithoutSecrets returns a copy without the encrypted container and password,
+// safe to return to clients.
+func (e AccountCertificateEntity) WithoutSecrets() AccountCertificateEntity {
+	e.EncryptedPayload = ""
+	e.EncryptedPassword = ""
+	return e
+}
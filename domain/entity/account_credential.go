@@ -7,6 +7,16 @@ import (
 	"github.com/google/uuid"
 )
 
+type AccountCredentialTypeEnum string
+
+const (
+	AccountCredentialType_Password AccountCredentialTypeEnum = "PASSWORD"
+	AccountCredentialType_Passkey  AccountCredentialTypeEnum = "PASSKEY"
+)
+
+// AccountCredentialEntity is one way for an account to sign in. A passkey
+// keeps its base64url credential id in ProviderSubject and its COSE public
+// key in PublicKey; an account may own several of them.
 type AccountCredentialEntity struct {
 	ID              uuid.UUID  `json:"id"`
 	CreatedAt       time.Time  `json:"created_at"`
@@ -15,6 +25,12 @@ type AccountCredentialEntity struct {
 	CredentialType  string     `json:"credential_type"`
 	ProviderSubject string     `json:"provider_subject"`
 	PasswordHash    string     `json:"password_hash"`
+	Name            string     `json:"name"`
+	PublicKey       string     `json:"public_key"`
+	SignCount       int64      `json:"sign_count"`
+	Aaguid          string     `json:"aaguid"`
+	BackupEligible  bool       `json:"backup_eligible"`
+	LastUsedAt      *time.Time `json:"last_used_at"`
 	AccountID       uuid.UUID  `json:"account_id"`
 }
 
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountEmailChangeEntity is a pending move of an account to a new email.
+// The new address confirms it with the confirm token, the previous address
+// may cancel it with the cancel token until it is confirmed.
+type AccountEmailChangeEntity struct {
+	ID               uuid.UUID  `json:"id"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	ConfirmedAt      *time.Time `json:"confirmed_at"`
+	CancelledAt      *time.Time `json:"cancelled_at"`
+	ConfirmTokenHash string     `json:"confirm_token_hash"`
+	CancelTokenHash  string     `json:"cancel_token_hash"`
+	PreviousEmail    string     `json:"previous_email"`
+	NewEmail         string     `json:"new_email"`
+	AccountID        uuid.UUID  `json:"account_id"`
+}
+
+// Pending tells whether the change can still be confirmed or cancelled.
+func (e *AccountEmailChangeEntity) Pending(now time.Time) bool {
+	return e != nil && e.ConfirmedAt == nil && e.CancelledAt == nil && e.ExpiresAt.After(now)
+}
+
+func (e *AccountEmailChangeEntity) MarshalJSON() ([]byte, error) {
+	type Alias AccountEmailChangeEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *AccountEmailChangeEntity) UnmarshalJSON(data []byte) error {
+	type Alias AccountEmailChangeEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
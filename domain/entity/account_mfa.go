@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountMfaEntity holds the TOTP enrollment of an account. It only protects
+// logins once ConfirmedAt is set. LastUsedStep is the last accepted time step,
+// so a code cannot be replayed within its validity window.
+type AccountMfaEntity struct {
+	ID              uuid.UUID  `json:"id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	ConfirmedAt     *time.Time `json:"confirmed_at"`
+	EncryptedSecret string     `json:"encrypted_secret"`
+	LastUsedStep    int64      `json:"last_used_step"`
+	AccountID       uuid.UUID  `json:"account_id"`
+}
+
+func (e *AccountMfaEntity) MarshalJSON() ([]byte, error) {
+	type Alias AccountMfaEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *AccountMfaEntity) UnmarshalJSON(data []byte) error {
+	type Alias AccountMfaEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
+
+func (e *AccountMfaEntity) Enabled() bool {
+	return e != nil && e.ConfirmedAt != nil
+}
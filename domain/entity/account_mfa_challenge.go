@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountMfaChallengeEntity is the pending second step of a login. The
+// client holds the challenge token; only its hash is stored.
+type AccountMfaChallengeEntity struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	TokenHash string    `json:"token_hash"`
+	Attempts  int       `json:"attempts"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	AccountID uuid.UUID `json:"account_id"`
+}
+
+func (e *AccountMfaChallengeEntity) MarshalJSON() ([]byte, error) {
+	type Alias AccountMfaChallengeEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *AccountMfaChallengeEntity) UnmarshalJSON(data []byte) error {
+	type Alias AccountMfaChallengeEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountRecoveryCodeEntity is a single-use code that replaces a TOTP code
+// when the authenticator is lost. Only its hash is stored.
+type AccountRecoveryCodeEntity struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	CodeHash  string     `json:"code_hash"`
+	AccountID uuid.UUID  `json:"account_id"`
+}
+
+func (e *AccountRecoveryCodeEntity) MarshalJSON() ([]byte, error) {
+	type Alias AccountRecoveryCodeEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *AccountRecoveryCodeEntity) UnmarshalJSON(data []byte) error {
+	type Alias AccountRecoveryCodeEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityEntity is an append-only audit record of an executed command.
+// Before and After only carry the fields that changed. ImpersonatorAccountID
+// is the platform admin who ran the command as ActorAccountID, if any.
+type ActivityEntity struct {
+	ID             uuid.UUID       `json:"id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Action         string          `json:"action"`
+	TargetType     string          `json:"target_type"`
+	TargetID       *string         `json:"target_id"`
+	Before         json.RawMessage `json:"before"`
+	After          json.RawMessage `json:"after"`
+	IP             string          `json:"ip"`
+	UserAgent      string          `json:"user_agent"`
+	ActorAccountID *uuid.UUID      `json:"actor_account_id"`
+	TenantID       *uuid.UUID      `json:"tenant_id"`
+
+	ImpersonatorAccountID *uuid.UUID `json:"impersonator_account_id"`
+}
+
+func (e *ActivityEntity) MarshalJSON() ([]byte, error) {
+	type Alias ActivityEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *ActivityEntity) UnmarshalJSON(data []byte) error {
+	type Alias ActivityEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
@@ -17,9 +17,9 @@ type BillingInvoiceEntity struct {
 	IsActiveFlag          bool                  `json:"is_active_flag"`
 	Status                string                `json:"status"`
 	CurrencyCode          string                `json:"currency_code"`
-	TotalAmount           float64               `json:"total_amount"`
-	TotalTaxAmount        float64               `json:"total_tax_amount"`
-	TotalDiscountAmount   float64               `json:"total_discount_amount"`
+	TotalAmount           Money                 `json:"total_amount"`
+	TotalTaxAmount        Money                 `json:"total_tax_amount"`
+	TotalDiscountAmount   Money                 `json:"total_discount_amount"`
 	IssuedAt              time.Time             `json:"issued_at"`
 	DueAt                 time.Time             `json:"due_at"`
 	PaidAt                time.Time             `json:"paid_at"`
@@ -25,7 +25,7 @@ type BillingPaymentEntity struct {
 	Period                BillingPlanPeriodEnum `json:"period"`
 	IsActiveFlag          bool                  `json:"is_active_flag"`
 	Status                string                `json:"status"`
-	Amount                float64               `json:"amount"`
+	Amount                Money                 `json:"amount"`
 	PaidAt                time.Time             `json:"paid_at"`
 	StripePaymentIntentID string                `json:"stripe_payment_intent_id"`
 	BillingInvoiceID      uuid.UUID             `json:"billing_invoice_id"`
@@ -18,7 +18,7 @@ type CurrencyExchangeRateEntity struct {
 	ID                uuid.UUID                      `json:"id"`
 	CreatedAt         time.Time                      `json:"created_at"`
 	EffectiveAt       time.Time                      `json:"effective_at"`
-	Rate              float64                        `json:"rate"`
+	Rate              Rate                           `json:"rate"`
 	Source            CurrencyExchangeRateSourceEnum `json:"source"`
 	BaseCurrencyCode  string                         `json:"base_currency_code"`
 	QuoteCurrencyCode string                         `json:"quote_currency_code"`
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImpersonationEntity records a platform admin acting as another account.
+// Its id is the session key of the impersonation token; AllowDestructive
+// lifts the block on destructive commands for that token.
+type ImpersonationEntity struct {
+	ID               uuid.UUID  `json:"id"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	EndedAt          *time.Time `json:"ended_at"`
+	Reason           string     `json:"reason"`
+	AllowDestructive bool       `json:"allow_destructive"`
+	IP               string     `json:"ip"`
+	UserAgent        string     `json:"user_agent"`
+	ActorAccountID   uuid.UUID  `json:"actor_account_id"`
+	AccountID        uuid.UUID  `json:"account_id"`
+}
+
+// Active tells whether the impersonation token may still be used.
+func (e *ImpersonationEntity) Active(now time.Time) bool {
+	return e != nil && e.EndedAt == nil && e.ExpiresAt.After(now)
+}
+
+func (e *ImpersonationEntity) MarshalJSON() ([]byte, error) {
+	type Alias ImpersonationEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *ImpersonationEntity) UnmarshalJSON(data []byte) error {
+	type Alias ImpersonationEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
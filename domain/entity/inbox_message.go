@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// InboxMessageEntity records that a consumer processed a stream message. It
+// is written in the same unit of work as the consumer's changes, and there
+// is at most one per message id and consumer.
+type InboxMessageEntity struct {
+	MessageID   string    `json:"message_id"`
+	Consumer    string    `json:"consumer"`
+	ProcessedAt time.Time `json:"processed_at"`
+}
+
+func (e *InboxMessageEntity) MarshalJSON() ([]byte, error) {
+	type Alias InboxMessageEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *InboxMessageEntity) UnmarshalJSON(data []byte) error {
+	type Alias InboxMessageEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
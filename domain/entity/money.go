@@ -0,0 +1,326 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strings"
+
+	"src/core/builder"
+)
+
+var (
+	ErrMoney_CurrencyMismatch = errors.New("money: currency mismatch")
+	ErrMoney_InvalidAmount    = errors.New("money: invalid amount")
+	ErrMoney_InvalidRatios    = errors.New("money: ratios must be non-negative and sum to more than zero")
+	ErrMoney_Overflow         = errors.New("money: amount overflows int64 minor units")
+)
+
+// Money is an amount of a currency expressed in integer minor units
+// (e.g. cents), so arithmetic never loses precision.
+type Money struct {
+	Amount       int64  `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+var _ json.Marshaler = Money{}
+var _ json.Unmarshaler = (*Money)(nil)
+var _ driver.Valuer = Money{}
+var _ builder.IWhereScopedOperand = Money{}
+
+func NewMoney(amount int64, currencyCode string) Money {
+	return Money{Amount: amount, CurrencyCode: strings.ToUpper(currencyCode)}
+}
+
+// ParseMoney converts a decimal string ("12.345") into minor units of the
+// given currency, rounding extra digits half to even.
+func ParseMoney(value string, currency *CurrencyEntity) (Money, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrMoney_InvalidAmount, value)
+	}
+	amount, err := roundHalfEven(rat.Mul(rat, minorUnitScale(currency.MinorUnit)))
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(amount, currency.Code), nil
+}
+
+func minorUnitScale(minorUnit int) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(minorUnit)), nil))
+}
+
+// roundHalfEven rounds to the nearest integer, ties going to the even
+// neighbour (banker's rounding).
+func roundHalfEven(value *big.Rat) (int64, error) {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() != 0 {
+		doubled := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+		step := big.NewInt(int64(value.Sign()))
+		switch doubled.Cmp(value.Denom()) {
+		case 1:
+			quotient.Add(quotient, step)
+		case 0:
+			if quotient.Bit(0) == 1 {
+				quotient.Add(quotient, step)
+			}
+		}
+	}
+	if !quotient.IsInt64() {
+		return 0, ErrMoney_Overflow
+	}
+	return quotient.Int64(), nil
+}
+
+// addMinorUnits returns a+b, failing when the sum leaves the int64 range.
+func addMinorUnits(a int64, b int64) (int64, error) {
+	sum := a + b
+	// the sum wrapped around when both operands share a sign it lost
+	if (a < 0) == (b < 0) && (sum < 0) != (a < 0) {
+		return 0, ErrMoney_Overflow
+	}
+	return sum, nil
+}
+
+// subtractMinorUnits returns a-b, failing when the difference leaves the
+// int64 range.
+func subtractMinorUnits(a int64, b int64) (int64, error) {
+	difference := a - b
+	if (a < 0) != (b < 0) && (difference < 0) != (a < 0) {
+		return 0, ErrMoney_Overflow
+	}
+	return difference, nil
+}
+
+// multiplyMinorUnits returns a*b, failing when the product leaves the int64
+// range.
+func multiplyMinorUnits(a int64, b int64) (int64, error) {
+	negative := (a < 0) != (b < 0)
+	hi, lo := bits.Mul64(absMinorUnits(a), absMinorUnits(b))
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++ // math.MinInt64 has no positive counterpart
+	}
+	if hi != 0 || lo > limit {
+		return 0, ErrMoney_Overflow
+	}
+	if negative {
+		return -int64(lo), nil
+	}
+	return int64(lo), nil
+}
+
+func absMinorUnits(value int64) uint64 {
+	if value < 0 {
+		return uint64(-value) // math.MinInt64 wraps to 1<<63, its magnitude
+	}
+	return uint64(value)
+}
+
+func (m Money) sameCurrency(other Money) error {
+	if m.CurrencyCode != other.CurrencyCode {
+		return fmt.Errorf("%w: %s and %s", ErrMoney_CurrencyMismatch, m.CurrencyCode, other.CurrencyCode)
+	}
+	return nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) Negate() (Money, error) {
+	amount, err := subtractMinorUnits(0, m.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, CurrencyCode: m.CurrencyCode}, nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	amount, err := addMinorUnits(m.Amount, other.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, CurrencyCode: m.CurrencyCode}, nil
+}
+
+func (m Money) Subtract(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	amount, err := subtractMinorUnits(m.Amount, other.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, CurrencyCode: m.CurrencyCode}, nil
+}
+
+// Compare returns -1, 0 or +1 like cmp.Compare, failing on different currencies.
+func (m Money) Compare(other Money) (int, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func (m Money) Multiply(factor int64) (Money, error) {
+	amount, err := multiplyMinorUnits(m.Amount, factor)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, CurrencyCode: m.CurrencyCode}, nil
+}
+
+// MultiplyRate scales the amount by an exact decimal factor (tax, discount,
+// etc.), rounding the result half to even.
+func (m Money) MultiplyRate(rate Rate) (Money, error) {
+	amount, err := roundHalfEven(new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate.rat()))
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, CurrencyCode: m.CurrencyCode}, nil
+}
+
+// Convert applies an exchange rate (quote units per base unit) and rescales
+// between the minor units of both currencies.
+func (m Money) Convert(rate Rate, from *CurrencyEntity, to *CurrencyEntity) (Money, error) {
+	if m.CurrencyCode != strings.ToUpper(from.Code) {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrMoney_CurrencyMismatch, m.CurrencyCode, from.Code)
+	}
+	value := new(big.Rat).SetInt64(m.Amount)
+	value.Quo(value, minorUnitScale(from.MinorUnit))
+	value.Mul(value, rate.rat())
+	value.Mul(value, minorUnitScale(to.MinorUnit))
+	amount, err := roundHalfEven(value)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(amount, to.Code), nil
+}
+
+// Allocate splits the amount proportionally to ratios. Leftover minor units
+// are handed out one by one from the first share, so the parts always sum
+// back to the original amount.
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrMoney_InvalidRatios
+		}
+		sum, err := addMinorUnits(total, ratio)
+		if err != nil {
+			return nil, err
+		}
+		total = sum
+	}
+	if total == 0 {
+		return nil, ErrMoney_InvalidRatios
+	}
+
+	parts := make([]Money, len(ratios))
+	remainder := m.Amount
+	for index, ratio := range ratios {
+		share := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(ratio))
+		share.Quo(share, big.NewInt(total))
+		parts[index] = Money{Amount: share.Int64(), CurrencyCode: m.CurrencyCode}
+		remainder -= share.Int64()
+	}
+
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for index := 0; remainder != 0; index = (index + 1) % len(parts) {
+		if ratios[index] == 0 {
+			continue
+		}
+		parts[index].Amount += step
+		remainder -= step
+	}
+
+	return parts, nil
+}
+
+// Split divides the amount into n parts that differ by at most one minor unit.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrMoney_InvalidRatios
+	}
+	ratios := make([]int64, n)
+	for index := range ratios {
+		ratios[index] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Format renders the amount as a plain decimal string using the currency
+// minor unit ("1234" BRL -> "12.34").
+func (m Money) Format(currency *CurrencyEntity) string {
+	value := new(big.Rat).SetFrac(big.NewInt(m.Amount), minorUnitScale(currency.MinorUnit).Num())
+	return value.FloatString(currency.MinorUnit)
+}
+
+func (m Money) WhereMember() string {
+	return "amount"
+}
+
+func (m Money) WhereValue() any {
+	return m.Amount
+}
+
+// WhereScope keeps filters within the currency: 100 USD never matches 100 EUR.
+func (m Money) WhereScope() map[string]any {
+	return map[string]any{"currency_code": m.CurrencyCode}
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	type Alias Money
+	return json.Marshal(Alias(m))
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	type Alias Money
+	if err := json.Unmarshal(data, (*Alias)(m)); err != nil {
+		return err
+	}
+	m.CurrencyCode = strings.ToUpper(m.CurrencyCode)
+	return nil
+}
+
+// Value stores Money as a JSONB document.
+func (m Money) Value() (driver.Value, error) {
+	return m.MarshalJSON()
+}
+
+// Scan reads Money back from a JSONB column.
+func (m *Money) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case []byte:
+		return m.UnmarshalJSON(value)
+	case string:
+		return m.UnmarshalJSON([]byte(value))
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+}
@@ -0,0 +1,193 @@
+package entity
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+var (
+	usd = &CurrencyEntity{Code: "USD", MinorUnit: 2}
+	jpy = &CurrencyEntity{Code: "JPY", MinorUnit: 0}
+	kwd = &CurrencyEntity{Code: "KWD", MinorUnit: 3}
+)
+
+func TestMoneyArithmeticOverflow(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation func() (Money, error)
+		amount    int64
+		err       error
+	}{
+		{name: "add", operation: func() (Money, error) { return NewMoney(150, "usd").Add(NewMoney(250, "USD")) }, amount: 400},
+		{name: "add up to max", operation: func() (Money, error) { return NewMoney(math.MaxInt64-1, "USD").Add(NewMoney(1, "USD")) }, amount: math.MaxInt64},
+		{name: "add past max", operation: func() (Money, error) { return NewMoney(math.MaxInt64, "USD").Add(NewMoney(1, "USD")) }, err: ErrMoney_Overflow},
+		{name: "add past min", operation: func() (Money, error) { return NewMoney(math.MinInt64, "USD").Add(NewMoney(-1, "USD")) }, err: ErrMoney_Overflow},
+		{name: "add other currency", operation: func() (Money, error) { return NewMoney(1, "USD").Add(NewMoney(1, "EUR")) }, err: ErrMoney_CurrencyMismatch},
+		{name: "subtract", operation: func() (Money, error) { return NewMoney(100, "USD").Subtract(NewMoney(250, "USD")) }, amount: -150},
+		{name: "subtract down to min", operation: func() (Money, error) { return NewMoney(-1, "USD").Subtract(NewMoney(math.MaxInt64, "USD")) }, amount: math.MinInt64},
+		{name: "subtract past min", operation: func() (Money, error) { return NewMoney(-2, "USD").Subtract(NewMoney(math.MaxInt64, "USD")) }, err: ErrMoney_Overflow},
+		{name: "subtract past max", operation: func() (Money, error) { return NewMoney(0, "USD").Subtract(NewMoney(math.MinInt64, "USD")) }, err: ErrMoney_Overflow},
+		{name: "negate", operation: func() (Money, error) { return NewMoney(math.MaxInt64, "USD").Negate() }, amount: -math.MaxInt64},
+		{name: "negate min", operation: func() (Money, error) { return NewMoney(math.MinInt64, "USD").Negate() }, err: ErrMoney_Overflow},
+		{name: "multiply", operation: func() (Money, error) { return NewMoney(-250, "USD").Multiply(4) }, amount: -1000},
+		{name: "multiply down to min", operation: func() (Money, error) { return NewMoney(math.MinInt64/2, "USD").Multiply(2) }, amount: math.MinInt64},
+		{name: "multiply past max", operation: func() (Money, error) { return NewMoney(math.MaxInt64/2+1, "USD").Multiply(2) }, err: ErrMoney_Overflow},
+		{name: "multiply min by -1", operation: func() (Money, error) { return NewMoney(math.MinInt64, "USD").Multiply(-1) }, err: ErrMoney_Overflow},
+		{name: "multiply past 128 bits", operation: func() (Money, error) { return NewMoney(math.MaxInt64, "USD").Multiply(math.MaxInt64) }, err: ErrMoney_Overflow},
+		{name: "multiply rate past max", operation: func() (Money, error) { return NewMoney(math.MaxInt64, "USD").MultiplyRate(MustParseRate("1.5")) }, err: ErrMoney_Overflow},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			money, err := test.operation()
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("got error %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if money.Amount != test.amount || money.CurrencyCode != "USD" {
+				t.Fatalf("got %d %s, want %d USD", money.Amount, money.CurrencyCode, test.amount)
+			}
+		})
+	}
+}
+
+func TestMoneyRoundsHalfToEven(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		rate   string
+		amount int64
+	}{
+		{name: "exact", value: "12.34", amount: 1234},
+		{name: "half down to even", value: "0.125", amount: 12},
+		{name: "half up to even", value: "0.135", amount: 14},
+		{name: "above half", value: "0.1251", amount: 13},
+		{name: "below half", value: "0.1349", amount: 13},
+		{name: "negative half down to even", value: "-0.125", amount: -12},
+		{name: "negative half up to even", value: "-0.135", amount: -14},
+		{name: "rate half down to even", value: "0.25", rate: "0.1", amount: 2},
+		{name: "rate half up to even", value: "0.35", rate: "0.1", amount: 4},
+		{name: "rate above half", value: "0.10", rate: "0.155", amount: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			money, err := ParseMoney(test.value, usd)
+			if err == nil && test.rate != "" {
+				money, err = money.MultiplyRate(MustParseRate(test.rate))
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if money.Amount != test.amount {
+				t.Fatalf("got %d, want %d", money.Amount, test.amount)
+			}
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		currency *CurrencyEntity
+		amount   int64
+		err      error
+	}{
+		{name: "no minor unit", value: "1500.5", currency: jpy, amount: 1500},
+		{name: "three minor units", value: " 1.2345 ", currency: kwd, amount: 1234},
+		{name: "invalid", value: "12,34", currency: usd, err: ErrMoney_InvalidAmount},
+		{name: "overflow", value: "92233720368547758.08", currency: usd, err: ErrMoney_Overflow},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			money, err := ParseMoney(test.value, test.currency)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("got error %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if money.Amount != test.amount || money.CurrencyCode != test.currency.Code {
+				t.Fatalf("got %d %s, want %d %s", money.Amount, money.CurrencyCode, test.amount, test.currency.Code)
+			}
+		})
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		ratios  []int64
+		amounts []int64
+		err     error
+	}{
+		{name: "even", amount: 100, ratios: []int64{1, 1}, amounts: []int64{50, 50}},
+		{name: "remainder from first share", amount: 100, ratios: []int64{1, 1, 1}, amounts: []int64{34, 33, 33}},
+		{name: "remainder over several shares", amount: 5, ratios: []int64{1, 1, 1, 1, 1, 1, 1}, amounts: []int64{1, 1, 1, 1, 1, 0, 0}},
+		{name: "proportional", amount: 1000, ratios: []int64{70, 20, 10}, amounts: []int64{700, 200, 100}},
+		{name: "proportional remainder", amount: 5, ratios: []int64{3, 7}, amounts: []int64{2, 3}},
+		{name: "zero ratio gets nothing", amount: 5, ratios: []int64{0, 1, 1}, amounts: []int64{0, 3, 2}},
+		{name: "negative amount", amount: -100, ratios: []int64{1, 1, 1}, amounts: []int64{-34, -33, -33}},
+		{name: "large amount", amount: math.MaxInt64, ratios: []int64{1, 1}, amounts: []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+		{name: "negative ratio", amount: 100, ratios: []int64{1, -1}, err: ErrMoney_InvalidRatios},
+		{name: "zero ratios", amount: 100, ratios: []int64{0, 0}, err: ErrMoney_InvalidRatios},
+		{name: "no ratios", amount: 100, err: ErrMoney_InvalidRatios},
+		{name: "ratio sum overflow", amount: 100, ratios: []int64{math.MaxInt64, 1}, err: ErrMoney_Overflow},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parts, err := NewMoney(test.amount, "USD").Allocate(test.ratios...)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("got error %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			amounts := make([]int64, len(parts))
+			for index, part := range parts {
+				if part.CurrencyCode != "USD" {
+					t.Fatalf("part %d has currency %s", index, part.CurrencyCode)
+				}
+				amounts[index] = part.Amount
+			}
+			if !slices.Equal(amounts, test.amounts) {
+				t.Fatalf("got %v, want %v", amounts, test.amounts)
+			}
+		})
+	}
+}
+
+func TestMoneySplit(t *testing.T) {
+	parts, err := NewMoney(1001, "USD").Split(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	amounts := make([]int64, len(parts))
+	for index, part := range parts {
+		amounts[index] = part.Amount
+	}
+	if want := []int64{251, 250, 250, 250}; !slices.Equal(amounts, want) {
+		t.Fatalf("got %v, want %v", amounts, want)
+	}
+
+	if _, err := NewMoney(1001, "USD").Split(0); !errors.Is(err, ErrMoney_InvalidRatios) {
+		t.Fatalf("got error %v, want %v", err, ErrMoney_InvalidRatios)
+	}
+}
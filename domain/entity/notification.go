@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationTypeEnum string
+
+const (
+	NotificationType_MembershipInvited     NotificationTypeEnum = "MEMBERSHIP_INVITED"
+	NotificationType_MembershipRoleChanged NotificationTypeEnum = "MEMBERSHIP_ROLE_CHANGED"
+	NotificationType_BillingPaymentFailed  NotificationTypeEnum = "BILLING_PAYMENT_FAILED"
+)
+
+// NotificationEntity is an in-app message for one account. It is unread
+// while ReadAt is nil and hidden once ExpiresAt has passed.
+type NotificationEntity struct {
+	ID        uuid.UUID            `json:"id"`
+	CreatedAt time.Time            `json:"created_at"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	ReadAt    *time.Time           `json:"read_at"`
+	Type      NotificationTypeEnum `json:"type"`
+	Payload   json.RawMessage      `json:"payload"`
+	AccountID uuid.UUID            `json:"account_id"`
+	TenantID  *uuid.UUID           `json:"tenant_id"`
+}
+
+func (e *NotificationEntity) MarshalJSON() ([]byte, error) {
+	type Alias NotificationEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *NotificationEntity) UnmarshalJSON(data []byte) error {
+	type Alias NotificationEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
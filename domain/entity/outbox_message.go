@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessageEntity is a stream message written in the same unit of work
+// as the change it announces; the relay publishes it once that committed.
+// A message is pending until SentAt is set, and is not retried before
+// AvailableAt.
+type OutboxMessageEntity struct {
+	ID          uuid.UUID       `json:"id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	AvailableAt time.Time       `json:"available_at"`
+	SentAt      *time.Time      `json:"sent_at"`
+	Attempts    int             `json:"attempts"`
+	LastError   string          `json:"last_error"`
+	Topic       string          `json:"topic"`
+	Key         string          `json:"key"`
+	Type        string          `json:"type"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+func (e *OutboxMessageEntity) MarshalJSON() ([]byte, error) {
+	type Alias OutboxMessageEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *OutboxMessageEntity) UnmarshalJSON(data []byte) error {
+	type Alias OutboxMessageEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlatformAdminRoleEnum string
+
+const (
+	PlatformAdminRole_Support PlatformAdminRoleEnum = "SUPPORT" // may impersonate, never run destructive commands
+	PlatformAdminRole_Admin   PlatformAdminRoleEnum = "ADMIN"   // may also allow destructive commands while impersonating
+)
+
+// PlatformAdminEntity grants an account staff rights over the whole
+// platform, independently of its tenant memberships.
+type PlatformAdminEntity struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	Role      string     `json:"role"`
+	AccountID uuid.UUID  `json:"account_id"`
+}
+
+func (e *PlatformAdminEntity) MarshalJSON() ([]byte, error) {
+	type Alias PlatformAdminEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *PlatformAdminEntity) UnmarshalJSON(data []byte) error {
+	type Alias PlatformAdminEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
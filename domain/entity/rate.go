@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"src/core/builder"
+)
+
+// RatePrecision is the number of decimal places kept when a Rate is serialized.
+const RatePrecision = 12
+
+var ErrRate_Invalid = errors.New("rate: invalid decimal value")
+
+// Rate is an exact decimal factor (exchange rates, tax rates, ...).
+// It is serialized as a JSON number so JSONB keeps it as numeric.
+type Rate struct {
+	value *big.Rat
+}
+
+var _ json.Marshaler = Rate{}
+var _ json.Unmarshaler = (*Rate)(nil)
+var _ driver.Valuer = Rate{}
+var _ builder.IWhereOperand = Rate{}
+
+func ParseRate(value string) (Rate, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %q", ErrRate_Invalid, value)
+	}
+	return Rate{value: rat}, nil
+}
+
+func MustParseRate(value string) Rate {
+	rate, err := ParseRate(value)
+	if err != nil {
+		panic(err)
+	}
+	return rate
+}
+
+func (r Rate) rat() *big.Rat {
+	if r.value == nil {
+		return new(big.Rat)
+	}
+	return r.value
+}
+
+func (r Rate) IsZero() bool {
+	return r.rat().Sign() == 0
+}
+
+// Inverse returns 1/r, used to derive quote->base rates.
+func (r Rate) Inverse() (Rate, error) {
+	if r.IsZero() {
+		return Rate{}, fmt.Errorf("%w: cannot invert zero", ErrRate_Invalid)
+	}
+	return Rate{value: new(big.Rat).Inv(r.rat())}, nil
+}
+
+func (r Rate) Multiply(other Rate) Rate {
+	return Rate{value: new(big.Rat).Mul(r.rat(), other.rat())}
+}
+
+func (r Rate) Cmp(other Rate) int {
+	return r.rat().Cmp(other.rat())
+}
+
+func (r Rate) String() string {
+	text := r.rat().FloatString(RatePrecision)
+	if strings.Contains(text, ".") {
+		text = strings.TrimRight(strings.TrimRight(text, "0"), ".")
+	}
+	return text
+}
+
+func (r Rate) WhereMember() string {
+	return ""
+}
+
+func (r Rate) WhereValue() any {
+	value, _ := r.rat().Float64()
+	return value
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	if text == "null" || text == "" {
+		*r = Rate{}
+		return nil
+	}
+	parsed, err := ParseRate(text)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+func (r Rate) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+func (r *Rate) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*r = Rate{}
+		return nil
+	case []byte:
+		return r.UnmarshalJSON(value)
+	case string:
+		return r.UnmarshalJSON([]byte(value))
+	case float64:
+		*r = Rate{value: new(big.Rat).SetFloat64(value)}
+		return nil
+	default:
+		return fmt.Errorf("rate: cannot scan %T", src)
+	}
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SignedDocumentEntity records a detached signature over a stored document.
+// DocumentHash is also the storage digest of the document, so the file can
+// only be read back while its content is unchanged.
+type SignedDocumentEntity struct {
+	ID                     uuid.UUID  `json:"id"`
+	CreatedAt              time.Time  `json:"created_at"`
+	SignedAt               time.Time  `json:"signed_at"`
+	DocumentPath           string     `json:"document_path"`
+	DocumentHash           string     `json:"document_hash"`
+	HashAlgorithm          string     `json:"hash_algorithm"`
+	SignaturePath          string     `json:"signature_path"`
+	SignatureHash          string     `json:"signature_hash"`
+	SignerSubject          string     `json:"signer_subject"`
+	CertificateFingerprint string     `json:"certificate_fingerprint"`
+	AccountCertificateID   uuid.UUID  `json:"account_certificate_id"`
+	AccountID              uuid.UUID  `json:"account_id"`
+	TenantID               *uuid.UUID `json:"tenant_id"`
+}
+
+func (e *SignedDocumentEntity) MarshalJSON() ([]byte, error) {
+	type Alias SignedDocumentEntity
+	return json.Marshal((*Alias)(e))
+}
+
+func (e *SignedDocumentEntity) UnmarshalJSON(data []byte) error {
+	type Alias SignedDocumentEntity
+	return json.Unmarshal(data, (*Alias)(e))
+}
@@ -12,6 +12,7 @@ type TenantConfigurationEntity struct {
 	UpdatedAt           time.Time `json:"updated_at"`
 	DefaultTimezone     string    `json:"default_timezone"`
 	DefaultCurrencyCode string    `json:"default_currency_code"`
+	RequireMfa          bool      `json:"require_mfa"` // members are asked to enroll TOTP when signing in
 	TenantID            uuid.UUID `json:"tenant_id"`
 }
 
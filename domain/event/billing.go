@@ -0,0 +1,23 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"src/domain/entity"
+)
+
+const (
+	EventName_BillingPaymentFailed = "billing.payment_failed"
+)
+
+type BillingPaymentFailed struct {
+	PaymentID        uuid.UUID    `json:"payment_id"`
+	Amount           entity.Money `json:"amount"`
+	Reason           string       `json:"reason"`
+	BillingInvoiceID uuid.UUID    `json:"billing_invoice_id"`
+	TenantID         uuid.UUID    `json:"tenant_id"`
+}
+
+func (BillingPaymentFailed) EventName() string {
+	return EventName_BillingPaymentFailed
+}
@@ -0,0 +1,12 @@
+package event
+
+// IDomainEvent is a fact that happened in the domain and other parts of the
+// system may react to.
+type IDomainEvent interface {
+	EventName() string
+}
+
+// IEventSource is implemented by command results that raised domain events.
+type IEventSource interface {
+	DomainEvents() []IDomainEvent
+}
@@ -0,0 +1,32 @@
+package event
+
+import "github.com/google/uuid"
+
+const (
+	EventName_MembershipInvited     = "membership.invited"
+	EventName_MembershipRoleChanged = "membership.role_changed"
+)
+
+type MembershipInvited struct {
+	InvitationID          uuid.UUID `json:"invitation_id"`
+	InvitedEmailAddress   string    `json:"invited_email_address"`
+	Role                  string    `json:"role"`
+	InvitedByMembershipID uuid.UUID `json:"invited_by_membership_id"`
+	TenantID              uuid.UUID `json:"tenant_id"`
+}
+
+func (MembershipInvited) EventName() string {
+	return EventName_MembershipInvited
+}
+
+type MembershipRoleChanged struct {
+	MembershipID uuid.UUID `json:"membership_id"`
+	PreviousRole string    `json:"previous_role"`
+	Role         string    `json:"role"`
+	AccountID    uuid.UUID `json:"account_id"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+}
+
+func (MembershipRoleChanged) EventName() string {
+	return EventName_MembershipRoleChanged
+}
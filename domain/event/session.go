@@ -0,0 +1,16 @@
+package event
+
+import "github.com/google/uuid"
+
+const (
+	EventName_AccountSessionRevoked = "account_session.revoked"
+)
+
+type AccountSessionRevoked struct {
+	SessionID uuid.UUID `json:"session_id"`
+	AccountID uuid.UUID `json:"account_id"`
+}
+
+func (AccountSessionRevoked) EventName() string {
+	return EventName_AccountSessionRevoked
+}
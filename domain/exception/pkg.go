@@ -8,6 +8,7 @@ import (
 	"src/domain/exception/not_acceptable"
 	"src/domain/exception/not_found"
 	"src/domain/exception/precondition_failed"
+	"src/domain/exception/too_many_requests"
 	"src/domain/exception/unauthorized"
 	"src/domain/exception/unprocessable_entity"
 	"src/domain/exception/validation"
@@ -21,6 +22,7 @@ type (
 	NotAcceptable       = not_acceptable.Exception
 	NotFound            = not_found.Exception
 	PreconditionFailed  = precondition_failed.Exception
+	TooManyRequests     = too_many_requests.Exception
 	Unauthorized        = unauthorized.Exception
 	UnprocessableEntity = unprocessable_entity.Exception
 	Validation          = validation.Exception
@@ -34,6 +36,7 @@ var (
 	NewNotAcceptable       = not_acceptable.New
 	NewNotFound            = not_found.New
 	NewPreconditionFailed  = precondition_failed.New
+	NewTooManyRequests     = too_many_requests.New
 	NewUnauthorized        = unauthorized.New
 	NewUnprocessableEntity = unprocessable_entity.New
 	NewValidation          = validation.New
@@ -47,6 +50,7 @@ func Register() {
 	not_acceptable.Register()
 	not_found.Register()
 	precondition_failed.Register()
+	too_many_requests.Register()
 	unauthorized.Register()
 	unprocessable_entity.Register()
 	validation.Register()
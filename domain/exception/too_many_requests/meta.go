@@ -0,0 +1,17 @@
+package too_many_requests
+
+import "src/core/meta"
+
+func Register() {
+	e := New()
+	meta.Describe(e,
+		meta.Description("Too many attempts were made; retry after the delay given in the Retry-After header"),
+		meta.Example(e),
+		meta.Field(&e.Code,
+			meta.Description("Machine-readable error code"),
+			meta.Example(DefaultCode)),
+		meta.Field(&e.Message,
+			meta.Description("Human-readable error message"),
+			meta.Example(DefaultMessage)),
+	)
+}
@@ -0,0 +1,46 @@
+package too_many_requests
+
+import (
+	"strconv"
+	"time"
+
+	"src/core"
+)
+
+const (
+	DefaultCode    = "TOO_MANY_REQUESTS"
+	DefaultMessage = "Too many attempts, retry later"
+)
+
+type Exception struct {
+	core.Error
+}
+
+func New() *Exception {
+	return &Exception{
+		Error: core.Error{
+			Code:    DefaultCode,
+			Message: DefaultMessage,
+		},
+	}
+}
+
+// WithRetryAfter records how long the caller has to wait. The delay is kept
+// as the cause of the error so it survives WithMessage.
+func (e *Exception) WithRetryAfter(delay time.Duration) *Exception {
+	e.Error = e.Error.WithCause(RetryAfter(delay))
+	return e
+}
+
+// RetryAfter is the delay carried by the exception, found with errors.As.
+type RetryAfter time.Duration
+
+func (r RetryAfter) Error() string {
+	return "retry after " + time.Duration(r).String()
+}
+
+// Seconds rounds the delay up to whole seconds, at least one.
+func (r RetryAfter) Seconds() string {
+	seconds := (time.Duration(r) + time.Second - 1) / time.Second
+	return strconv.FormatInt(int64(max(seconds, 1)), 10)
+}
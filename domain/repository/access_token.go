@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccessTokenRepository interface {
+	Insert(ctx context.Context, token *entity.AccessTokenEntity, optionalUow ...common.IUnitOfWork) error
+	GetByID(ctx context.Context, id uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.AccessTokenEntity, error)
+	GetByPrefix(ctx context.Context, prefix string, optionalUow ...common.IUnitOfWork) (*entity.AccessTokenEntity, error)
+	// ListPersonal returns the personal tokens of an account, newest first.
+	ListPersonal(ctx context.Context, accountID uuid.UUID, includeRevoked bool, optionalUow ...common.IUnitOfWork) ([]entity.AccessTokenEntity, error)
+	// ListByTenantID returns the API keys of a tenant, newest first.
+	ListByTenantID(ctx context.Context, tenantID uuid.UUID, includeRevoked bool, optionalUow ...common.IUnitOfWork) ([]entity.AccessTokenEntity, error)
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+}
@@ -2,13 +2,26 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
 
 	"src/core/common"
 	"src/domain/entity"
 )
 
+// ErrAccount_EmailTaken is returned when another account already has the
+// email, which the unique email index enforces.
+var ErrAccount_EmailTaken = errors.New("account: email is already in use")
+
 type IAccountRepository interface {
 	CountByEmail(ctx context.Context, email string, optionalUow ...common.IUnitOfWork) (int64, error)
+	GetByID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.AccountEntity, error)
 	GetByEmail(ctx context.Context, email string, optionalUow ...common.IUnitOfWork) (*entity.AccountEntity, error)
 	ActivateByEmail(ctx context.Context, email string, optionalUow ...common.IUnitOfWork) (*entity.AccountEntity, error)
+	// UpdateEmail moves an account from previous to email; it returns 0 when
+	// the account no longer has the previous email and ErrAccount_EmailTaken
+	// when another account has email.
+	UpdateEmail(ctx context.Context, accountID uuid.UUID, previous string, email string, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
 }
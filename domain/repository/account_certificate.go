@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/domain/entity"
+)
+
+// IAccountCertificateRepository operations are scoped to the owner account.
+// Listings never load the encrypted container or password.
+type IAccountCertificateRepository interface {
+	Insert(ctx context.Context, certificate *entity.AccountCertificateEntity, optionalUow ...common.IUnitOfWork) error
+	GetByID(ctx context.Context, accountID uuid.UUID, certificateID uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.AccountCertificateEntity, error)
+	ExistsByFingerprint(ctx context.Context, accountID uuid.UUID, fingerprint string, optionalUow ...common.IUnitOfWork) (bool, error)
+	List(ctx context.Context, accountID uuid.UUID, includeRevoked bool, offset int64, limit int64, optionalUow ...common.IUnitOfWork) (*builder.Result[entity.AccountCertificateEntity], error)
+	// ListExpiring returns unrevoked certificates still valid at from that expire up to until.
+	ListExpiring(ctx context.Context, accountID uuid.UUID, from time.Time, until time.Time, offset int64, limit int64, optionalUow ...common.IUnitOfWork) (*builder.Result[entity.AccountCertificateEntity], error)
+	Revoke(ctx context.Context, accountID uuid.UUID, certificateID uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	Delete(ctx context.Context, accountID uuid.UUID, certificateID uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+}
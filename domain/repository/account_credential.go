@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccountCredentialRepository interface {
+	// GetByAccountID returns the undeleted credential of the given type, or nil.
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, credentialType entity.AccountCredentialTypeEnum, optionalUow ...common.IUnitOfWork) (*entity.AccountCredentialEntity, error)
+	// ListByAccountID returns every undeleted credential of the given type, oldest first.
+	ListByAccountID(ctx context.Context, accountID uuid.UUID, credentialType entity.AccountCredentialTypeEnum, optionalUow ...common.IUnitOfWork) ([]entity.AccountCredentialEntity, error)
+	// GetByProviderSubject returns the undeleted credential of the given type and subject, or nil.
+	GetByProviderSubject(ctx context.Context, credentialType entity.AccountCredentialTypeEnum, providerSubject string, optionalUow ...common.IUnitOfWork) (*entity.AccountCredentialEntity, error)
+	Insert(ctx context.Context, credential *entity.AccountCredentialEntity, optionalUow ...common.IUnitOfWork) error
+	// UpdateSignCount moves the signature counter from previous to signCount,
+	// so two concurrent assertions cannot both be accepted.
+	UpdateSignCount(ctx context.Context, id uuid.UUID, previous int64, signCount int64, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	SoftDelete(ctx context.Context, id uuid.UUID, accountID uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+}
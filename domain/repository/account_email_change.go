@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccountEmailChangeRepository interface {
+	Insert(ctx context.Context, change *entity.AccountEmailChangeEntity, optionalUow ...common.IUnitOfWork) error
+	GetByConfirmTokenHash(ctx context.Context, tokenHash string, optionalUow ...common.IUnitOfWork) (*entity.AccountEmailChangeEntity, error)
+	GetByCancelTokenHash(ctx context.Context, tokenHash string, optionalUow ...common.IUnitOfWork) (*entity.AccountEmailChangeEntity, error)
+	// CancelPending cancels every open change of an account, so only the
+	// latest request can be confirmed.
+	CancelPending(ctx context.Context, accountID uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	// Confirm and Cancel only affect a change that is still open; they
+	// return 0 when another request settled it first.
+	Confirm(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	Cancel(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccountMfaRepository interface {
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.AccountMfaEntity, error)
+	Insert(ctx context.Context, mfa *entity.AccountMfaEntity, optionalUow ...common.IUnitOfWork) error
+	// Confirm enables a pending enrollment; it affects no row once confirmed.
+	Confirm(ctx context.Context, accountID uuid.UUID, step int64, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	// UseStep records an accepted time step; it affects no row for a step
+	// that is not newer than the last one, which makes the code a replay.
+	UseStep(ctx context.Context, accountID uuid.UUID, step int64, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	DeleteByAccountID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccountMfaChallengeRepository interface {
+	Insert(ctx context.Context, challenge *entity.AccountMfaChallengeEntity, optionalUow ...common.IUnitOfWork) error
+	GetByTokenHash(ctx context.Context, tokenHash string, optionalUow ...common.IUnitOfWork) (*entity.AccountMfaChallengeEntity, error)
+	// Attempt counts a failed answer; it affects no row when another request
+	// counted one since attempts was read.
+	Attempt(ctx context.Context, challengeID uuid.UUID, attempts int, optionalUow ...common.IUnitOfWork) (int64, error)
+	Delete(ctx context.Context, challengeID uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccountRecoveryCodeRepository interface {
+	Insert(ctx context.Context, codes []entity.AccountRecoveryCodeEntity, optionalUow ...common.IUnitOfWork) error
+	CountUnused(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+	// Use spends an unused code; it affects no row for an unknown or used code.
+	Use(ctx context.Context, accountID uuid.UUID, codeHash string, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	DeleteByAccountID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+}
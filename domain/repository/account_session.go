@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IAccountSessionRepository interface {
+	Insert(ctx context.Context, session *entity.AccountSessionEntity, optionalUow ...common.IUnitOfWork) error
+	// ListActiveByAccountID returns the unrevoked sessions of an account.
+	ListActiveByAccountID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) ([]entity.AccountSessionEntity, error)
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+}
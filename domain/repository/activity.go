@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/domain/entity"
+)
+
+// IActivityRepository is append-only: activities are never updated or deleted.
+type IActivityRepository interface {
+	Insert(ctx context.Context, activity *entity.ActivityEntity, optionalUow ...common.IUnitOfWork) error
+	Search(ctx context.Context, query *builder.Query[entity.ActivityEntity], optionalUow ...common.IUnitOfWork) (*builder.Result[entity.ActivityEntity], error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type ICurrencyRepository interface {
+	List(ctx context.Context, onlyActive bool, optionalUow ...common.IUnitOfWork) ([]entity.CurrencyEntity, error)
+	GetByCode(ctx context.Context, code string, optionalUow ...common.IUnitOfWork) (*entity.CurrencyEntity, error)
+	// LockByCode reads the currency as GetByCode does and locks it until the
+	// transaction ends: exclusively to change it, shared to rely on it.
+	LockByCode(ctx context.Context, code string, exclusive bool, optionalUow ...common.IUnitOfWork) (*entity.CurrencyEntity, error)
+	Insert(ctx context.Context, currencies []entity.CurrencyEntity, optionalUow ...common.IUnitOfWork) error
+	// Update overwrites name, minor unit, symbol and activation of the currency with the same code.
+	Update(ctx context.Context, currency *entity.CurrencyEntity, optionalUow ...common.IUnitOfWork) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type ICurrencyExchangeRateRepository interface {
+	Insert(ctx context.Context, rates []entity.CurrencyExchangeRateEntity, optionalUow ...common.IUnitOfWork) error
+	// Exists reports whether a rate for the pair, source and instant is already stored.
+	Exists(ctx context.Context, baseCurrencyCode string, quoteCurrencyCode string, source entity.CurrencyExchangeRateSourceEnum, effectiveAt time.Time, optionalUow ...common.IUnitOfWork) (bool, error)
+	// GetEffective returns the latest rate for the pair with EffectiveAt <= at.
+	// Manual overrides win over provider rates published at the same instant,
+	// and the latest recorded one among several of the same source.
+	GetEffective(ctx context.Context, baseCurrencyCode string, quoteCurrencyCode string, at time.Time, optionalUow ...common.IUnitOfWork) (*entity.CurrencyExchangeRateEntity, error)
+	ListHistory(ctx context.Context, baseCurrencyCode string, quoteCurrencyCode string, from *time.Time, to *time.Time, offset int64, limit int64, optionalUow ...common.IUnitOfWork) (*builder.Result[entity.CurrencyExchangeRateEntity], error)
+}
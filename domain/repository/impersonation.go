@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IImpersonationRepository interface {
+	Insert(ctx context.Context, impersonation *entity.ImpersonationEntity, optionalUow ...common.IUnitOfWork) error
+	GetByID(ctx context.Context, id uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.ImpersonationEntity, error)
+	// End only affects an impersonation not ended yet; it returns 0 otherwise.
+	End(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+}
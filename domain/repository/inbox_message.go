@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IInboxMessageRepository interface {
+	// Insert returns false, and inserts nothing, when the consumer already
+	// processed the message.
+	Insert(ctx context.Context, message *entity.InboxMessageEntity, optionalUow ...common.IUnitOfWork) (bool, error)
+}
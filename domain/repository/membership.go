@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IMembershipRepository interface {
+	ListActiveByAccountID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) ([]entity.MembershipEntity, error)
+	ListActiveByTenantID(ctx context.Context, tenantID uuid.UUID, optionalUow ...common.IUnitOfWork) ([]entity.MembershipEntity, error)
+	// LockActiveByTenantID lists the active memberships of the tenant as
+	// ListActiveByTenantID does and locks them until the transaction ends, so
+	// role changes within a tenant run one at a time.
+	LockActiveByTenantID(ctx context.Context, tenantID uuid.UUID, optionalUow ...common.IUnitOfWork) ([]entity.MembershipEntity, error)
+	UpdateRole(ctx context.Context, membershipID uuid.UUID, role entity.MembershipRoleEnum, optionalUow ...common.IUnitOfWork) (int64, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/domain/entity"
+)
+
+// INotificationRepository operations are scoped to the recipient account so
+// nobody can read or change someone else's notifications.
+type INotificationRepository interface {
+	Insert(ctx context.Context, notifications []entity.NotificationEntity, optionalUow ...common.IUnitOfWork) error
+	Search(ctx context.Context, query *builder.Query[entity.NotificationEntity], optionalUow ...common.IUnitOfWork) (*builder.Result[entity.NotificationEntity], error)
+	// CountUnread counts notifications not read and not expired at the given instant.
+	CountUnread(ctx context.Context, accountID uuid.UUID, tenantID *uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	MarkRead(ctx context.Context, accountID uuid.UUID, notificationID uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	MarkAllRead(ctx context.Context, accountID uuid.UUID, tenantID *uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	Delete(ctx context.Context, accountID uuid.UUID, notificationID uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+}
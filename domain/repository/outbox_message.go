@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IOutboxMessageRepository interface {
+	Insert(ctx context.Context, message *entity.OutboxMessageEntity, optionalUow ...common.IUnitOfWork) error
+	// ClaimPending locks up to limit pending messages available at now, oldest
+	// first, skipping the ones another relay already locked. The lock lasts
+	// until the unit of work ends, so it must be given one.
+	ClaimPending(ctx context.Context, now time.Time, limit int64, optionalUow ...common.IUnitOfWork) ([]*entity.OutboxMessageEntity, error)
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	Reschedule(ctx context.Context, id uuid.UUID, attempts int, availableAt time.Time, lastError string, optionalUow ...common.IUnitOfWork) (int64, error)
+	DeleteSentBefore(ctx context.Context, before time.Time, optionalUow ...common.IUnitOfWork) (int64, error)
+	CountPending(ctx context.Context, optionalUow ...common.IUnitOfWork) (int64, error)
+	GetOldestPending(ctx context.Context, optionalUow ...common.IUnitOfWork) (*entity.OutboxMessageEntity, error)
+}
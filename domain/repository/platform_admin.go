@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type IPlatformAdminRepository interface {
+	// GetActiveByAccountID returns the unrevoked grant of an account, or nil.
+	GetActiveByAccountID(ctx context.Context, accountID uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.PlatformAdminEntity, error)
+}
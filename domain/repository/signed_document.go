@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/builder"
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type ISignedDocumentRepository interface {
+	Insert(ctx context.Context, document *entity.SignedDocumentEntity, optionalUow ...common.IUnitOfWork) error
+	GetByID(ctx context.Context, documentID uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.SignedDocumentEntity, error)
+	Search(ctx context.Context, query *builder.Query[entity.SignedDocumentEntity], optionalUow ...common.IUnitOfWork) (*builder.Result[entity.SignedDocumentEntity], error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/core/common"
+	"src/domain/entity"
+)
+
+type ITenantConfigurationRepository interface {
+	CountByDefaultCurrencyCode(ctx context.Context, currencyCode string, optionalUow ...common.IUnitOfWork) (int64, error)
+	GetByTenantID(ctx context.Context, tenantID uuid.UUID, optionalUow ...common.IUnitOfWork) (*entity.TenantConfigurationEntity, error)
+	// Update overwrites the defaults and the MFA requirement of the tenant.
+	Update(ctx context.Context, configuration *entity.TenantConfigurationEntity, optionalUow ...common.IUnitOfWork) (int64, error)
+	CountRequiringMfa(ctx context.Context, tenantIDs []uuid.UUID, optionalUow ...common.IUnitOfWork) (int64, error)
+}
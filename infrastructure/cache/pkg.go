@@ -11,7 +11,8 @@ import (
 )
 
 func init() {
-	di.RegisterAs[adapter.ICacheAdapter](func() adapter.ICacheAdapter {
+	// singleton: the connections are shared, and closed with the container
+	di.SingletonAs[adapter.ICacheAdapter](func() adapter.ICacheAdapter {
 		config := &adapter.CacheConfig{
 			RedisURI:  env.Get("CACHE_REDIS_URI", "redis://localhost:6379/0"),
 			ShortTTL:  time.Minute * 15,
@@ -29,6 +29,10 @@ func (a *RedisCacheAdapter) Config() *adapter.CacheConfig {
 	return a.config
 }
 
+func (a *RedisCacheAdapter) Close() error {
+	return a.client.Close()
+}
+
 func (a *RedisCacheAdapter) Ping(ctx context.Context) error {
 	return a.client.Ping(ctx).Err()
 }
@@ -60,6 +64,19 @@ func (a *RedisCacheAdapter) Get(ctx context.Context, key string) (string, bool,
 	return res, true, nil
 }
 
+func (a *RedisCacheAdapter) Increment(ctx context.Context, key string, timeToLive time.Duration) (int64, error) {
+	var incr *goredis.IntCmd
+	_, err := a.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, timeToLive)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
 func (a *RedisCacheAdapter) Delete(ctx context.Context, key string) error {
 	return a.client.Del(ctx, key).Err()
 }
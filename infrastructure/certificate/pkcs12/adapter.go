@@ -0,0 +1,112 @@
+package pkcs12
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	gopkcs12 "golang.org/x/crypto/pkcs12"
+
+	adapter "src/application/adapter/certificate"
+)
+
+type Pkcs12CertificateAdapter struct {
+	config *adapter.CertificateConfig
+}
+
+var _ adapter.ICertificateAdapter = (*Pkcs12CertificateAdapter)(nil)
+
+func NewPkcs12CertificateAdapter(config *adapter.CertificateConfig) *Pkcs12CertificateAdapter {
+	if config == nil {
+		panic("certificate/pkcs12: config is nil")
+	}
+	return &Pkcs12CertificateAdapter{config: config}
+}
+
+func (a *Pkcs12CertificateAdapter) Config() *adapter.CertificateConfig {
+	return a.config
+}
+
+func (a *Pkcs12CertificateAdapter) ParsePKCS12(content []byte, password string) (*adapter.CertificateInfo, error) {
+	certificate, _, _, err := open(content, password)
+	if err != nil {
+		return nil, err
+	}
+	return describe(certificate), nil
+}
+
+func describe(certificate *x509.Certificate) *adapter.CertificateInfo {
+	fingerprint := sha256.Sum256(certificate.Raw)
+	return &adapter.CertificateInfo{
+		Subject:      certificate.Subject.String(),
+		Issuer:       certificate.Issuer.String(),
+		SerialNumber: strings.ToUpper(certificate.SerialNumber.Text(16)),
+		Fingerprint:  hex.EncodeToString(fingerprint[:]),
+		NotBefore:    certificate.NotBefore.UTC(),
+		NotAfter:     certificate.NotAfter.UTC(),
+	}
+}
+
+// open decodes a container into its end-entity certificate, private key and
+// the remaining certificates of the chain.
+func open(content []byte, password string) (*x509.Certificate, crypto.Signer, []*x509.Certificate, error) {
+	blocks, err := gopkcs12.ToPEM(content, password)
+	if err != nil {
+		if errors.Is(err, gopkcs12.ErrIncorrectPassword) {
+			return nil, nil, nil, adapter.ErrCertificate_IncorrectPassword
+		}
+		return nil, nil, nil, errors.Join(adapter.ErrCertificate_Invalid, err)
+	}
+
+	var (
+		certificates []*x509.Certificate
+		privateKey   crypto.Signer
+	)
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, nil, errors.Join(adapter.ErrCertificate_Invalid, err)
+			}
+			certificates = append(certificates, certificate)
+		case "PRIVATE KEY":
+			// ToPEM keeps PKCS #1 bytes for RSA and SEC 1 bytes for EC keys
+			if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+				privateKey = key
+			} else if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+				privateKey = key
+			} else {
+				return nil, nil, nil, errors.Join(adapter.ErrCertificate_Invalid, err)
+			}
+		}
+	}
+
+	if privateKey == nil || len(certificates) == 0 {
+		return nil, nil, nil, adapter.ErrCertificate_Invalid
+	}
+
+	for index, certificate := range certificates {
+		if samePublicKey(certificate.PublicKey, privateKey.Public()) {
+			chain := append(append([]*x509.Certificate{}, certificates[:index]...), certificates[index+1:]...)
+			return certificate, privateKey, chain, nil
+		}
+	}
+	return nil, nil, nil, errors.Join(adapter.ErrCertificate_Invalid, errors.New("no certificate matches the private key"))
+}
+
+func samePublicKey(certificateKey any, privateKey crypto.PublicKey) bool {
+	switch key := certificateKey.(type) {
+	case *rsa.PublicKey:
+		return key.Equal(privateKey)
+	case *ecdsa.PublicKey:
+		return key.Equal(privateKey)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,254 @@
+package pkcs12
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	"time"
+
+	adapter "src/application/adapter/certificate"
+)
+
+// RFC 5652 and RFC 5754 object identifiers.
+var (
+	oidData               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidAttrContentType    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidAttrMessageDigest  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidAttrSigningTime    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+	oidDigestSHA256       = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSignatureRSA       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidSignatureECDSA256  = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidSignatureRSAsha256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+)
+
+// contentInfo wraps its content in an explicit [0]. encoding/asn1 does not
+// apply tag parameters to a RawValue, so Content is the [0] element itself.
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+// encapsulatedContentInfo carries no eContent: signatures are detached.
+type encapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+}
+
+type signerInfo struct {
+	Version            int
+	SID                issuerAndSerialNumber
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+func (a *Pkcs12CertificateAdapter) SignDetached(content io.Reader, container []byte, password string) (*adapter.Signature, error) {
+	certificate, privateKey, chain, err := open(container, password)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, content); err != nil {
+		return nil, err
+	}
+	digest := hasher.Sum(nil)
+	signedAt := time.Now().UTC().Truncate(time.Second)
+
+	attributes, err := signedAttributes(digest, signedAt)
+	if err != nil {
+		return nil, err
+	}
+	// the signature covers the attributes encoded as an explicit SET OF
+	attributesSet, err := asn1.MarshalWithParams(attributes, "set")
+	if err != nil {
+		return nil, err
+	}
+	attributesDigest := sha256.Sum256(attributesSet)
+	signature, err := privateKey.Sign(rand.Reader, attributesDigest[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureAlgorithm := pkix.AlgorithmIdentifier{Algorithm: oidSignatureECDSA256}
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		signatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidSignatureRSA, Parameters: asn1.NullRawValue}
+	}
+
+	var certificates []byte
+	for _, c := range append([]*x509.Certificate{certificate}, chain...) {
+		certificates = append(certificates, c.Raw...)
+	}
+
+	var attributesValue asn1.RawValue
+	if _, err := asn1.Unmarshal(attributesSet, &attributesValue); err != nil {
+		return nil, err
+	}
+	signed, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: oidDigestSHA256}},
+		EncapContentInfo: encapsulatedContentInfo{EContentType: oidData},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certificates},
+		SignerInfos: []signerInfo{{
+			Version: 1,
+			SID: issuerAndSerialNumber{
+				Issuer:       asn1.RawValue{FullBytes: certificate.RawIssuer},
+				SerialNumber: certificate.SerialNumber,
+			},
+			DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: oidDigestSHA256},
+			SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attributesValue.Bytes},
+			SignatureAlgorithm: signatureAlgorithm,
+			Signature:          signature,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signed},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.Signature{
+		Content:  encoded,
+		Digest:   hex.EncodeToString(digest),
+		SignedAt: signedAt,
+		Signer:   *describe(certificate),
+	}, nil
+}
+
+func (a *Pkcs12CertificateAdapter) VerifyDetached(content io.Reader, signature []byte) (*adapter.Signature, error) {
+	var info contentInfo
+	if rest, err := asn1.Unmarshal(signature, &info); err != nil || len(rest) > 0 || !info.ContentType.Equal(oidSignedData) {
+		return nil, adapter.ErrCertificate_InvalidSignature
+	}
+	var signed signedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &signed); err != nil || len(signed.SignerInfos) != 1 {
+		return nil, adapter.ErrCertificate_InvalidSignature
+	}
+	signer := signed.SignerInfos[0]
+	if !signer.DigestAlgorithm.Algorithm.Equal(oidDigestSHA256) || len(signer.SignedAttrs.FullBytes) == 0 {
+		return nil, adapter.ErrCertificate_InvalidSignature
+	}
+
+	certificates, err := x509.ParseCertificates(signed.Certificates.Bytes)
+	if err != nil {
+		return nil, errors.Join(adapter.ErrCertificate_InvalidSignature, err)
+	}
+	var certificate *x509.Certificate
+	for _, c := range certificates {
+		if bytes.Equal(c.RawIssuer, signer.SID.Issuer.FullBytes) && c.SerialNumber.Cmp(signer.SID.SerialNumber) == 0 {
+			certificate = c
+			break
+		}
+	}
+	if certificate == nil {
+		return nil, errors.Join(adapter.ErrCertificate_InvalidSignature, errors.New("signer certificate is not embedded"))
+	}
+
+	// re-tag the implicit [0] as the SET OF that was actually signed
+	attributesSet := append([]byte{0x31}, signer.SignedAttrs.FullBytes[1:]...)
+	var attributes []attribute
+	if _, err := asn1.UnmarshalWithParams(attributesSet, &attributes, "set"); err != nil {
+		return nil, errors.Join(adapter.ErrCertificate_InvalidSignature, err)
+	}
+	var (
+		messageDigest []byte
+		signedAt      time.Time
+	)
+	for _, attr := range attributes {
+		if len(attr.Values) != 1 {
+			continue
+		}
+		switch {
+		case attr.Type.Equal(oidAttrMessageDigest):
+			_, err = asn1.Unmarshal(attr.Values[0].FullBytes, &messageDigest)
+		case attr.Type.Equal(oidAttrSigningTime):
+			_, err = asn1.Unmarshal(attr.Values[0].FullBytes, &signedAt)
+		}
+		if err != nil {
+			return nil, errors.Join(adapter.ErrCertificate_InvalidSignature, err)
+		}
+	}
+	if messageDigest == nil {
+		return nil, errors.Join(adapter.ErrCertificate_InvalidSignature, errors.New("messageDigest attribute is missing"))
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, content); err != nil {
+		return nil, err
+	}
+	digest := hasher.Sum(nil)
+	if !bytes.Equal(digest, messageDigest) {
+		return nil, adapter.ErrCertificate_SignatureMismatch
+	}
+
+	algorithm := x509.SHA256WithRSA
+	if _, ok := certificate.PublicKey.(*ecdsa.PublicKey); ok {
+		algorithm = x509.ECDSAWithSHA256
+	} else if !signer.SignatureAlgorithm.Algorithm.Equal(oidSignatureRSA) && !signer.SignatureAlgorithm.Algorithm.Equal(oidSignatureRSAsha256) {
+		return nil, adapter.ErrCertificate_InvalidSignature
+	}
+	if err := certificate.CheckSignature(algorithm, attributesSet, signer.Signature); err != nil {
+		return nil, errors.Join(adapter.ErrCertificate_SignatureMismatch, err)
+	}
+
+	return &adapter.Signature{
+		Content:  signature,
+		Digest:   hex.EncodeToString(digest),
+		SignedAt: signedAt.UTC(),
+		Signer:   *describe(certificate),
+	}, nil
+}
+
+func signedAttributes(digest []byte, signedAt time.Time) ([]attribute, error) {
+	contentType, err := asn1.Marshal(oidData)
+	if err != nil {
+		return nil, err
+	}
+	messageDigest, err := asn1.Marshal(digest)
+	if err != nil {
+		return nil, err
+	}
+	signingTime, err := asn1.Marshal(signedAt)
+	if err != nil {
+		return nil, err
+	}
+	return []attribute{
+		{Type: oidAttrContentType, Values: []asn1.RawValue{{FullBytes: contentType}}},
+		{Type: oidAttrMessageDigest, Values: []asn1.RawValue{{FullBytes: messageDigest}}},
+		{Type: oidAttrSigningTime, Values: []asn1.RawValue{{FullBytes: signingTime}}},
+	}, nil
+}
@@ -0,0 +1,20 @@
+package certificate
+
+import (
+	adapter "src/application/adapter/certificate"
+	"src/core/di"
+	"src/core/env"
+	impl "src/infrastructure/certificate/pkcs12"
+)
+
+func init() {
+	di.RegisterAs[adapter.ICertificateAdapter](func() adapter.ICertificateAdapter {
+		config := &adapter.CertificateConfig{
+			ExpiryWarningDays: env.Get("CERTIFICATE_EXPIRY_WARNING_DAYS", 30),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return impl.NewPkcs12CertificateAdapter(config)
+	})
+}
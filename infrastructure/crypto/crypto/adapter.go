@@ -5,6 +5,7 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -13,9 +14,20 @@ import (
 	"io"
 	"strings"
 
+	"golang.org/x/crypto/argon2"
+
 	adapter "src/application/adapter/crypto"
 )
 
+// Parâmetros do argon2id usados por HashPassword (mínimo recomendado pela OWASP).
+const (
+	passwordTime      = 2
+	passwordMemory    = 19 * 1024
+	passwordThreads   = 1
+	passwordKeyLength = 32
+	passwordSaltSize  = 16
+)
+
 type CryptoAdapter struct {
 	key []byte
 }
@@ -43,6 +55,50 @@ func (a *CryptoAdapter) Hash(plainText string) string {
 	return base64.RawURLEncoding.EncodeToString(sum[:])
 }
 
+// HashPassword gera um hash argon2id com salt aleatório no formato PHC
+// "$argon2id$v=19$m=...,t=...,p=...$salt$hash".
+func (a *CryptoAdapter) HashPassword(plainText string) string {
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		panic(err)
+	}
+	key := argon2.IDKey([]byte(plainText), salt, passwordTime, passwordMemory, passwordThreads, passwordKeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, passwordMemory, passwordTime, passwordThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))
+}
+
+// VerifyPassword recalcula o argon2id com o salt e os parâmetros gravados no
+// próprio hash, então hashes antigos continuam válidos se os parâmetros mudarem.
+func (a *CryptoAdapter) VerifyPassword(plainText string, hash string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return false
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false
+	}
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil || time == 0 || threads == 0 {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false
+	}
+
+	computed := argon2.IDKey([]byte(plainText), salt, time, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(computed, key) == 1
+}
+
 // Encrypt serializa o valor como JSON e cifra com AES-256-GCM.
 // optionalIV, se não vazio, é usado como IV em texto (mesma semântica do TS).
 // Retorna string no formato "iv.encrypted.tag".
@@ -57,11 +113,6 @@ func (a *CryptoAdapter) Encrypt(plainText any, optionalIV ...string) string {
 		panic(fmt.Errorf("crypto: failed to create cipher: %w", err))
 	}
 
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		panic(fmt.Errorf("crypto: failed to create GCM: %w", err))
-	}
-
 	var ivStr string
 	if len(optionalIV) > 0 {
 		ivStr = optionalIV[0]
@@ -73,7 +124,13 @@ func (a *CryptoAdapter) Encrypt(plainText any, optionalIV ...string) string {
 		ivStr = base64.RawURLEncoding.EncodeToString(nonce)
 	}
 
+	// the IV travels as text and its bytes are used as-is (same as the TS
+	// service), so the nonce is longer than the GCM default
 	nonce := []byte(ivStr)
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(nonce))
+	if err != nil {
+		panic(fmt.Errorf("crypto: failed to create GCM: %w", err))
+	}
 
 	cipherWithTag := gcm.Seal(nil, nonce, plainBytes, nil)
 	tagSize := gcm.Overhead()
@@ -114,12 +171,11 @@ func (a *CryptoAdapter) Decrypt(cipherText string) (any, error) {
 		return nil, fmt.Errorf("crypto: failed to create cipher: %w", err)
 	}
 
-	gcm, err := cipher.NewGCM(block)
+	nonce := []byte(ivStr)
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(nonce))
 	if err != nil {
 		return nil, fmt.Errorf("crypto: failed to create GCM: %w", err)
 	}
-
-	nonce := []byte(ivStr)
 	combined := append(cipherBytes, tagBytes...)
 
 	plainBytes, err := gcm.Open(nil, nonce, combined, nil)
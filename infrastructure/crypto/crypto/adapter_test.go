@@ -0,0 +1,48 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	adapter "src/application/adapter/crypto"
+)
+
+func TestVerifyPassword(t *testing.T) {
+	cryptoAdapter := NewCryptoAdapter(&adapter.CryptoConfig{Key: strings.Repeat("k", 32)})
+	hash := cryptoAdapter.HashPassword("Correct-Horse-9")
+
+	if again := cryptoAdapter.HashPassword("Correct-Horse-9"); again == hash {
+		t.Fatal("two hashes of the same password share their salt")
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$") {
+		t.Fatalf("hash %q is not in the argon2id PHC format", hash)
+	}
+
+	parts := strings.Split(hash, "$")
+	tests := []struct {
+		name     string
+		password string
+		hash     string
+		match    bool
+	}{
+		{name: "match", password: "Correct-Horse-9", hash: hash, match: true},
+		{name: "other password", password: "Correct-Horse-8", hash: hash},
+		{name: "empty password", password: "", hash: hash},
+		{name: "empty hash", password: "Correct-Horse-9", hash: ""},
+		{name: "sha-256 digest", password: "Correct-Horse-9", hash: cryptoAdapter.Hash("Correct-Horse-9")},
+		{name: "other algorithm", password: "Correct-Horse-9", hash: strings.Replace(hash, "argon2id", "argon2i", 1)},
+		{name: "other version", password: "Correct-Horse-9", hash: strings.Replace(hash, "v=19", "v=16", 1)},
+		{name: "zero threads", password: "Correct-Horse-9", hash: strings.Replace(hash, "p=1", "p=0", 1)},
+		{name: "other parameters", password: "Correct-Horse-9", hash: strings.Replace(hash, "t=2", "t=3", 1)},
+		{name: "corrupt salt", password: "Correct-Horse-9", hash: strings.Join(append(parts[:4:4], "!", parts[5]), "$")},
+		{name: "empty key", password: "Correct-Horse-9", hash: strings.Join(append(parts[:5:5], ""), "$")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := cryptoAdapter.VerifyPassword(test.password, test.hash); got != test.match {
+				t.Fatalf("VerifyPassword = %v, want %v", got, test.match)
+			}
+		})
+	}
+}
@@ -47,6 +47,36 @@ func escapeJSONField(field string) string {
 	return strings.ReplaceAll(field, "'", "''")
 }
 
+// jsonFieldExpr returns the text accessor for a JSON field. Dotted names
+// ("total_amount.amount") address members of nested JSON objects.
+func jsonFieldExpr(field string) string {
+	return jsonPathExpr(field, true)
+}
+
+// jsonValueExpr returns the JSONB accessor for a JSON field, as
+// jsonFieldExpr does for its text.
+func jsonValueExpr(field string) string {
+	return jsonPathExpr(field, false)
+}
+
+func jsonPathExpr(field string, asText bool) string {
+	operator, pathOperator := "->", "#>"
+	if asText {
+		operator, pathOperator = "->>", "#>>"
+	}
+
+	if !strings.Contains(field, ".") {
+		return fmt.Sprintf("data%s'%s'", operator, escapeJSONField(field))
+	}
+
+	segments := strings.Split(field, ".")
+	for i, segment := range segments {
+		segment = strings.ReplaceAll(segment, `\`, `\\`)
+		segments[i] = `"` + strings.ReplaceAll(escapeJSONField(segment), `"`, `\"`) + `"`
+	}
+	return fmt.Sprintf("data%s'{%s}'", pathOperator, strings.Join(segments, ","))
+}
+
 func castJSONField(baseExpr string, goType reflect.Type) string {
 	if goType == nil {
 		return baseExpr
@@ -100,85 +130,155 @@ func sqlOperator(op builder.WhereEnum) (string, error) {
 }
 
 func (e *pgxExecutor) buildWhereAndArgs(text *string, where *builder.WherePointerMap, index int) (string, []any, error) {
+	conditions := &whereConditions{next: index}
 	var parts []string
-	var args []any
-	idx := index
-
-	addArg := func(v any) int {
-		args = append(args, v)
-		pos := idx
-		idx++
-		return pos
-	}
 
 	if text != nil && *text != "" {
-		pos := addArg(*text)
+		pos := conditions.addArg(*text)
 		parts = append(parts, fmt.Sprintf("data::text ILIKE '%%' || $%d || '%%'", pos))
 	}
 
 	if where != nil {
-		// ordenar campos só pra deixar determinístico
-		fieldNames := make([]string, 0, len(*where))
-		for field := range *where {
-			fieldNames = append(fieldNames, field)
+		whereParts, err := conditions.build(*where)
+		if err != nil {
+			return "", nil, err
 		}
-		sort.Strings(fieldNames)
+		parts = append(parts, whereParts...)
+	}
 
-		for _, field := range fieldNames {
-			ops := (*where)[field]
+	if len(parts) == 0 {
+		return "", conditions.args, nil
+	}
 
-			opKeys := make([]builder.WhereEnum, 0, len(ops))
-			for op := range ops {
-				opKeys = append(opKeys, op)
-			}
-			sort.Slice(opKeys, func(i, j int) bool { return string(opKeys[i]) < string(opKeys[j]) })
+	return strings.Join(parts, " AND "), conditions.args, nil
+}
 
-			baseExpr := fmt.Sprintf("data->>'%s'", escapeJSONField(field))
+// whereConditions translates a builder.WherePointerMap into SQL conditions,
+// numbering their arguments from next.
+type whereConditions struct {
+	args []any
+	next int
+}
 
+func (c *whereConditions) addArg(v any) int {
+	c.args = append(c.args, v)
+	pos := c.next
+	c.next++
+	return pos
+}
+
+// build returns one condition per clause of where, all of which must hold.
+func (c *whereConditions) build(where builder.WherePointerMap) ([]string, error) {
+	var parts []string
+
+	// ordenar campos só pra deixar determinístico
+	fieldNames := make([]string, 0, len(where))
+	for field := range where {
+		fieldNames = append(fieldNames, field)
+	}
+	sort.Strings(fieldNames)
+
+	for _, field := range fieldNames {
+		ops := where[field]
+
+		opKeys := make([]builder.WhereEnum, 0, len(ops))
+		for op := range ops {
+			opKeys = append(opKeys, op)
+		}
+		sort.Slice(opKeys, func(i, j int) bool { return string(opKeys[i]) < string(opKeys[j]) })
+
+		if builder.IsWhereGroupKey(field) {
 			for _, op := range opKeys {
-				val := ops[op]
-
-				switch op {
-				case builder.WhereEnum_Empty:
-					parts = append(parts, fmt.Sprintf("COALESCE(%s, '') = ''", baseExpr))
-				case builder.WhereEnum_NotEmpty:
-					parts = append(parts, fmt.Sprintf("COALESCE(%s, '') <> ''", baseExpr))
-				case builder.WhereEnum_In, builder.WhereEnum_NotIn:
-					v := reflect.ValueOf(val)
-					if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
-						return "", nil, fmt.Errorf("IN/NIN expects slice/array for field %s", field)
-					}
-					elemType := v.Type().Elem()
-					typedExpr := castJSONField(baseExpr, elemType)
-
-					pos := addArg(val)
-
-					if op == builder.WhereEnum_In {
-						parts = append(parts, fmt.Sprintf("%s = ANY($%d)", typedExpr, pos))
-					} else {
-						parts = append(parts, fmt.Sprintf("NOT (%s = ANY($%d))", typedExpr, pos))
-					}
-				default:
-					goType := reflect.TypeOf(val)
-					typedExpr := castJSONField(baseExpr, goType)
-
-					sqlOp, err := sqlOperator(op)
-					if err != nil {
-						return "", nil, err
-					}
-
-					pos := addArg(val)
-					parts = append(parts, fmt.Sprintf("%s %s $%d", typedExpr, sqlOp, pos))
+				part, err := c.buildGroup(field, op, ops[op])
+				if err != nil {
+					return nil, err
+				}
+				if part != "" {
+					parts = append(parts, part)
+				}
+			}
+			continue
+		}
+
+		baseExpr := jsonFieldExpr(field)
+
+		for _, op := range opKeys {
+			val := ops[op]
+
+			switch op {
+			case builder.WhereEnum_Empty:
+				parts = append(parts, fmt.Sprintf("COALESCE(%s, '') = ''", baseExpr))
+			case builder.WhereEnum_NotEmpty:
+				parts = append(parts, fmt.Sprintf("COALESCE(%s, '') <> ''", baseExpr))
+			case builder.WhereEnum_In, builder.WhereEnum_NotIn:
+				v := reflect.ValueOf(val)
+				if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+					return nil, fmt.Errorf("IN/NIN expects slice/array for field %s", field)
+				}
+				elemType := v.Type().Elem()
+				typedExpr := castJSONField(baseExpr, elemType)
+
+				pos := c.addArg(val)
+
+				if op == builder.WhereEnum_In {
+					parts = append(parts, fmt.Sprintf("%s = ANY($%d)", typedExpr, pos))
+				} else {
+					parts = append(parts, fmt.Sprintf("NOT (%s = ANY($%d))", typedExpr, pos))
+				}
+			case builder.WhereEnum_Contains, builder.WhereEnum_ArrayContains:
+				if op == builder.WhereEnum_ArrayContains {
+					val = []any{val}
+				}
+				encoded, err := json.Marshal(val)
+				if err != nil {
+					return nil, fmt.Errorf("%s expects a JSON value for field %s: %w", op, field, err)
 				}
+
+				pos := c.addArg(string(encoded))
+				parts = append(parts, fmt.Sprintf("%s @> $%d::jsonb", jsonValueExpr(field), pos))
+			default:
+				goType := reflect.TypeOf(val)
+				typedExpr := castJSONField(baseExpr, goType)
+
+				sqlOp, err := sqlOperator(op)
+				if err != nil {
+					return nil, err
+				}
+
+				pos := c.addArg(val)
+				parts = append(parts, fmt.Sprintf("%s %s $%d", typedExpr, sqlOp, pos))
 			}
 		}
 	}
 
+	return parts, nil
+}
+
+// buildGroup returns the condition of a group clause, empty when the group
+// holds no condition.
+func (c *whereConditions) buildGroup(key string, op builder.WhereEnum, val any) (string, error) {
+	group, err := builder.AsWherePointerMap(val)
+	if err != nil {
+		return "", fmt.Errorf("where group %s: %w", key, err)
+	}
+	parts, err := c.build(group)
+	if err != nil {
+		return "", err
+	}
 	if len(parts) == 0 {
-		return "", args, nil
+		return "", nil
 	}
 
-	return strings.Join(parts, " AND "), args, nil
+	switch op {
+	case builder.WhereEnum_And:
+		return "(" + strings.Join(parts, " AND ") + ")", nil
+	case builder.WhereEnum_Or:
+		return "(" + strings.Join(parts, " OR ") + ")", nil
+	case builder.WhereEnum_Not:
+		return "NOT (" + strings.Join(parts, " AND ") + ")", nil
+	default:
+		return "", fmt.Errorf("unsupported where group operator: %s", op)
+	}
 }
 
 func (e *pgxExecutor) buildWhereFromQuery(query *builder.Query[json.RawMessage], index int) (string, []any, error) {
@@ -218,7 +318,7 @@ func (e *pgxExecutor) buildOrderByClause(query *builder.Query[json.RawMessage])
 		if sortMap[field] == builder.SortEnum_Desc {
 			dir = "DESC"
 		}
-		parts = append(parts, fmt.Sprintf("data->>'%s' %s", escapeJSONField(field), dir))
+		parts = append(parts, fmt.Sprintf("%s %s", jsonFieldExpr(field), dir))
 	}
 
 	if len(parts) == 0 {
@@ -228,6 +328,22 @@ func (e *pgxExecutor) buildOrderByClause(query *builder.Query[json.RawMessage])
 	return strings.Join(parts, ", ")
 }
 
+func lockClause(query *builder.Query[json.RawMessage]) string {
+	if query == nil || query.LockCond == nil {
+		return ""
+	}
+	switch *query.LockCond {
+	case builder.LockEnum_ForUpdateSkipLocked:
+		return " FOR UPDATE SKIP LOCKED"
+	case builder.LockEnum_ForUpdate:
+		return " FOR UPDATE"
+	case builder.LockEnum_ForShare:
+		return " FOR SHARE"
+	default:
+		return ""
+	}
+}
+
 func (e *pgxExecutor) FindOne(ctx context.Context, table string, query *builder.Query[json.RawMessage]) (*json.RawMessage, error) {
 	where, args, err := e.buildWhereFromQuery(query, 1)
 	if err != nil {
@@ -245,6 +361,7 @@ func (e *pgxExecutor) FindOne(ctx context.Context, table string, query *builder.
 	}
 
 	sql += " LIMIT 1"
+	sql += lockClause(query)
 
 	row := e.runner.QueryRow(ctx, sql, args...)
 
@@ -294,6 +411,7 @@ func (e *pgxExecutor) FindMany(ctx context.Context, table string, query *builder
 	}
 
 	sql += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	sql += lockClause(query)
 
 	rows, err := e.runner.Query(ctx, sql, args...)
 	if err != nil {
@@ -486,11 +604,21 @@ func (p *PgxDatabaseAdapter) Ping(ctx context.Context) error {
 	return p.pool.Ping(ctx)
 }
 
+// BeginTransaction opens a transaction, or a savepoint when ctx already
+// carries one, so nested commands can fail without undoing their caller.
 func (p *PgxDatabaseAdapter) BeginTransaction(ctx context.Context) (common.IUnitOfWork, error) {
 	if p.pool == nil {
 		return nil, errors.New("PgxDatabaseAdapter: pool is nil")
 	}
 
+	if outer, ok := common.GetUnitOfWork(ctx).(*PgxTransaction); ok {
+		savepoint, err := outer.tx.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &PgxTransaction{tx: savepoint}, nil
+	}
+
 	tx, err := p.pool.Begin(ctx)
 	if err != nil {
 		return nil, err
@@ -499,14 +627,20 @@ func (p *PgxDatabaseAdapter) BeginTransaction(ctx context.Context) (common.IUnit
 	return &PgxTransaction{tx: tx}, nil
 }
 
-func (p *PgxDatabaseAdapter) pickExecutor(optionalUow ...common.IUnitOfWork) (*pgxExecutor, error) {
-	if len(optionalUow) == 0 || optionalUow[0] == nil {
+// pickExecutor runs on the given unit of work, else on the one carried by
+// ctx, else on the pool.
+func (p *PgxDatabaseAdapter) pickExecutor(ctx context.Context, optionalUow ...common.IUnitOfWork) (*pgxExecutor, error) {
+	uow := common.GetUnitOfWork(ctx)
+	if len(optionalUow) > 0 && optionalUow[0] != nil {
+		uow = optionalUow[0]
+	}
+	if uow == nil {
 		return p.exec, nil
 	}
 
-	tx, ok := optionalUow[0].(*PgxTransaction)
+	tx, ok := uow.(*PgxTransaction)
 	if !ok {
-		return nil, fmt.Errorf("PgxDatabaseAdapter: unexpected transaction type %T", optionalUow[0])
+		return nil, fmt.Errorf("PgxDatabaseAdapter: unexpected transaction type %T", uow)
 	}
 
 	return &pgxExecutor{
@@ -516,7 +650,7 @@ func (p *PgxDatabaseAdapter) pickExecutor(optionalUow ...common.IUnitOfWork) (*p
 }
 
 func (p *PgxDatabaseAdapter) FindOne(ctx context.Context, table string, query *builder.Query[json.RawMessage], optionalUow ...common.IUnitOfWork) (*json.RawMessage, error) {
-	exec, err := p.pickExecutor(optionalUow...)
+	exec, err := p.pickExecutor(ctx, optionalUow...)
 	if err != nil {
 		return nil, err
 	}
@@ -524,7 +658,7 @@ func (p *PgxDatabaseAdapter) FindOne(ctx context.Context, table string, query *b
 }
 
 func (p *PgxDatabaseAdapter) FindMany(ctx context.Context, table string, query *builder.Query[json.RawMessage], optionalUow ...common.IUnitOfWork) (*builder.Result[json.RawMessage], error) {
-	exec, err := p.pickExecutor(optionalUow...)
+	exec, err := p.pickExecutor(ctx, optionalUow...)
 	if err != nil {
 		return nil, err
 	}
@@ -532,7 +666,7 @@ func (p *PgxDatabaseAdapter) FindMany(ctx context.Context, table string, query *
 }
 
 func (p *PgxDatabaseAdapter) Count(ctx context.Context, table string, query *builder.Query[json.RawMessage], optionalUow ...common.IUnitOfWork) (int64, error) {
-	exec, err := p.pickExecutor(optionalUow...)
+	exec, err := p.pickExecutor(ctx, optionalUow...)
 	if err != nil {
 		return 0, err
 	}
@@ -540,7 +674,7 @@ func (p *PgxDatabaseAdapter) Count(ctx context.Context, table string, query *bui
 }
 
 func (p *PgxDatabaseAdapter) Insert(ctx context.Context, table string, entities []json.RawMessage, optionalUow ...common.IUnitOfWork) error {
-	exec, err := p.pickExecutor(optionalUow...)
+	exec, err := p.pickExecutor(ctx, optionalUow...)
 	if err != nil {
 		return err
 	}
@@ -548,7 +682,7 @@ func (p *PgxDatabaseAdapter) Insert(ctx context.Context, table string, entities
 }
 
 func (p *PgxDatabaseAdapter) Update(ctx context.Context, table string, where *builder.WhereBuilder[json.RawMessage], update *builder.UpdateBuilder[json.RawMessage], optionalUow ...common.IUnitOfWork) (int64, error) {
-	exec, err := p.pickExecutor(optionalUow...)
+	exec, err := p.pickExecutor(ctx, optionalUow...)
 	if err != nil {
 		return 0, err
 	}
@@ -556,7 +690,7 @@ func (p *PgxDatabaseAdapter) Update(ctx context.Context, table string, where *bu
 }
 
 func (p *PgxDatabaseAdapter) Delete(ctx context.Context, table string, where *builder.WhereBuilder[json.RawMessage], optionalUow ...common.IUnitOfWork) (int64, error) {
-	exec, err := p.pickExecutor(optionalUow...)
+	exec, err := p.pickExecutor(ctx, optionalUow...)
 	if err != nil {
 		return 0, err
 	}
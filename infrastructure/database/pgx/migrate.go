@@ -0,0 +1,87 @@
+package pgx
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// migrations are the schema and data changes the repositories rely on,
+// applied in the order of their file names ("0001_<name>.sql"). A file is
+// never edited once released; later changes go into a new one.
+//
+//go:embed migration/*.sql
+var migrations embed.FS
+
+const migrationTable = `"control_plane"."schema_migration"`
+
+// Migrate applies the migrations not yet recorded in the schema_migration
+// table, all in one transaction. Replicas starting together wait on an
+// advisory lock, so each migration runs once.
+func (p *PgxDatabaseAdapter) Migrate(ctx context.Context) error {
+	if p.pool == nil {
+		return errors.New("PgxDatabaseAdapter: pool is nil")
+	}
+
+	names, err := fs.Glob(migrations, "migration/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // no-op once committed
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('schema_migration'))`); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+migrationTable+` (
+		version text PRIMARY KEY,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		return err
+	}
+
+	applied := map[string]bool{}
+	rows, err := tx.Query(ctx, `SELECT version FROM `+migrationTable)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return err
+		}
+		applied[version] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		version := strings.TrimSuffix(strings.TrimPrefix(name, "migration/"), ".sql")
+		if applied[version] {
+			continue
+		}
+		script, err := migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, string(script)); err != nil {
+			return errors.Join(errors.New("migration "+version+" failed"), err)
+		}
+		if _, err := tx.Exec(ctx, `INSERT INTO `+migrationTable+` (version) VALUES ($1)`, version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,45 @@
+-- Billing amounts moved from decimal numbers in major units to Money objects
+-- in integer minor units: 12.34 BRL becomes {"amount": 1234, "currency_code": "BRL"}.
+-- Payments take the currency of their invoice. Rates keep their JSON number
+-- shape and need no change.
+DO $$
+BEGIN
+	IF to_regclass('"control_plane"."billing_invoice"') IS NOT NULL THEN
+		UPDATE "control_plane"."billing_invoice" AS invoice
+		SET data = invoice.data || (
+			SELECT jsonb_object_agg(field, jsonb_build_object(
+				'amount', round((invoice.data->>field)::numeric * power(10::numeric, unit.minor_unit))::bigint,
+				'currency_code', upper(invoice.data->>'currency_code')
+			))
+			FROM unnest(ARRAY['total_amount', 'total_tax_amount', 'total_discount_amount']) AS field
+			CROSS JOIN (
+				SELECT COALESCE((
+					SELECT (currency.data->>'minor_unit')::int
+					FROM "control_plane"."currency" AS currency
+					WHERE currency.data->>'code' = upper(invoice.data->>'currency_code')
+				), 2) AS minor_unit
+			) AS unit
+			WHERE jsonb_typeof(invoice.data->field) = 'number'
+		)
+		WHERE jsonb_typeof(invoice.data->'total_amount') = 'number'
+			OR jsonb_typeof(invoice.data->'total_tax_amount') = 'number'
+			OR jsonb_typeof(invoice.data->'total_discount_amount') = 'number';
+	END IF;
+
+	IF to_regclass('"control_plane"."billing_payment"') IS NOT NULL
+		AND to_regclass('"control_plane"."billing_invoice"') IS NOT NULL THEN
+		UPDATE "control_plane"."billing_payment" AS payment
+		SET data = payment.data || jsonb_build_object('amount', jsonb_build_object(
+			'amount', round((payment.data->>'amount')::numeric * power(10::numeric, COALESCE((
+				SELECT (currency.data->>'minor_unit')::int
+				FROM "control_plane"."currency" AS currency
+				WHERE currency.data->>'code' = upper(invoice.data->>'currency_code')
+			), 2)))::bigint,
+			'currency_code', upper(invoice.data->>'currency_code')
+		))
+		FROM "control_plane"."billing_invoice" AS invoice
+		WHERE invoice.data->>'id' = payment.data->>'billing_invoice_id'
+			AND jsonb_typeof(payment.data->'amount') = 'number';
+	END IF;
+END
+$$;
@@ -0,0 +1,5 @@
+-- An email address belongs to one account at most, whatever its case.
+-- Confirming an email change relies on this index when two changes race
+-- for the same address.
+CREATE UNIQUE INDEX IF NOT EXISTS account_email_unique
+	ON "control_plane"."account" (lower(data->>'email'));
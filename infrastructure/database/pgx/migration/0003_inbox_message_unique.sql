@@ -0,0 +1,10 @@
+-- A consumer processes a message at most once: a second inbox record for
+-- the same message and consumer waits for the first transaction and fails
+-- once it committed.
+DO $$
+BEGIN
+	IF to_regclass('"control_plane"."inbox_message"') IS NOT NULL THEN
+		CREATE UNIQUE INDEX IF NOT EXISTS inbox_message_unique
+			ON "control_plane"."inbox_message" ((data->>'message_id'), (data->>'consumer'));
+	END IF;
+END $$;
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccessTokenRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccessTokenRepository = (*PgxAccessTokenRepository)(nil)
+
+func NewPgxAccessTokenRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccessTokenRepository {
+	return &PgxAccessTokenRepository{
+		tableName:       `"control_plane"."access_token"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccessTokenRepository) Insert(
+	ctx context.Context,
+	token *entity.AccessTokenEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccessTokenRepository) GetByID(
+	ctx context.Context,
+	id uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccessTokenEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccessTokenEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccessTokenEntity]().
+				Where(func(e *entity.AccessTokenEntity, q *builder.WhereBuilder[entity.AccessTokenEntity]) {
+					q.Equal(&e.ID, id.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccessTokenRepository) GetByPrefix(
+	ctx context.Context,
+	prefix string,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccessTokenEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccessTokenEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccessTokenEntity]().
+				Where(func(e *entity.AccessTokenEntity, q *builder.WhereBuilder[entity.AccessTokenEntity]) {
+					q.Equal(&e.Prefix, prefix)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccessTokenRepository) ListPersonal(
+	ctx context.Context,
+	accountID uuid.UUID,
+	includeRevoked bool,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.AccessTokenEntity, error) {
+	return r.list(ctx, func(e *entity.AccessTokenEntity, q *builder.WhereBuilder[entity.AccessTokenEntity]) {
+		q.Equal(&e.AccountID, accountID.String()).
+			Equal(&e.Kind, string(entity.AccessTokenKind_Personal))
+		if !includeRevoked {
+			q.Empty(&e.RevokedAt)
+		}
+	}, optionalUow...)
+}
+
+func (r *PgxAccessTokenRepository) ListByTenantID(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	includeRevoked bool,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.AccessTokenEntity, error) {
+	return r.list(ctx, func(e *entity.AccessTokenEntity, q *builder.WhereBuilder[entity.AccessTokenEntity]) {
+		q.Equal(&e.TenantID, tenantID.String()).
+			Equal(&e.Kind, string(entity.AccessTokenKind_Tenant))
+		if !includeRevoked {
+			q.Empty(&e.RevokedAt)
+		}
+	}, optionalUow...)
+}
+
+func (r *PgxAccessTokenRepository) list(
+	ctx context.Context,
+	where builder.WhereFn[entity.AccessTokenEntity],
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.AccessTokenEntity, error) {
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName,
+		builder.NewQuery[entity.AccessTokenEntity]().
+			Where(where).
+			Sort(func(e *entity.AccessTokenEntity, s *builder.SortBuilder[entity.AccessTokenEntity]) {
+				s.Desc(&e.CreatedAt)
+			}).
+			Limit(1000).
+			ToJSON(),
+		optionalUow...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := builder.NewResultFromRaw[entity.AccessTokenEntity](result)
+	if err != nil || tokens == nil {
+		return nil, err
+	}
+	return tokens.Items, nil
+}
+
+func (r *PgxAccessTokenRepository) Revoke(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccessTokenEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Empty(&where.Entity().RevokedAt)
+	update := builder.NewUpdate[entity.AccessTokenEntity]()
+	update.Set(&update.Entity().RevokedAt, at.UTC()).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccessTokenRepository) Touch(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccessTokenEntity]()
+	where.Equal(&where.Entity().ID, id.String())
+	update := builder.NewUpdate[entity.AccessTokenEntity]()
+	update.Set(&update.Entity().LastUsedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccessTokenRepository](NewPgxAccessTokenRepository)
+}
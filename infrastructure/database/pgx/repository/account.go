@@ -2,7 +2,12 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
 	"src/application/adapter/database"
 	"src/core/builder"
 	"src/core/common"
@@ -13,7 +18,6 @@ import (
 
 type PgxAccountRepository struct {
 	tableName       string
-	entityType      entity.AccountEntity
 	databaseAdapter database.IDatabaseAdapter
 }
 
@@ -24,7 +28,6 @@ func NewPgxAccountRepository(
 ) *PgxAccountRepository {
 	return &PgxAccountRepository{
 		tableName:       `"control_plane"."account"`,
-		entityType:      entity.AccountEntity{},
 		databaseAdapter: databaseAdapter,
 	}
 }
@@ -37,13 +40,30 @@ func (r *PgxAccountRepository) CountByEmail(
 	return r.databaseAdapter.Count(ctx, r.tableName,
 		builder.NewQuery[entity.AccountEntity]().
 			Where(func(e *entity.AccountEntity, q *builder.WhereBuilder[entity.AccountEntity]) {
-				q.Equal(&r.entityType.Email, email)
+				q.Equal(&e.Email, email)
 			}).
 			ToJSON(),
 		optionalUow...,
 	)
 }
 
+func (r *PgxAccountRepository) GetByID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountEntity]().
+				Where(func(e *entity.AccountEntity, q *builder.WhereBuilder[entity.AccountEntity]) {
+					q.Equal(&e.ID, accountID.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
 func (r *PgxAccountRepository) GetByEmail(
 	ctx context.Context,
 	email string,
@@ -51,18 +71,12 @@ func (r *PgxAccountRepository) GetByEmail(
 ) (*entity.AccountEntity, error) {
 	query := builder.NewQuery[entity.AccountEntity]().
 		Where(func(e *entity.AccountEntity, q *builder.WhereBuilder[entity.AccountEntity]) {
-			q.Equal(&r.entityType.Email, email)
+			q.Equal(&e.Email, email)
 		}).
 		ToJSON()
-	jsonAccount, err := r.databaseAdapter.FindOne(ctx, r.tableName, query, optionalUow...)
-	if err != nil {
-		return nil, err
-	}
-	var typedAccount entity.AccountEntity
-	if err := json.Unmarshal(*jsonAccount, &typedAccount); err != nil {
-		return nil, err
-	}
-	return &typedAccount, nil
+	return database.TypedFromJsonWithErr[entity.AccountEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName, query, optionalUow...),
+	)
 }
 
 func (r *PgxAccountRepository) ActivateByEmail(
@@ -70,13 +84,15 @@ func (r *PgxAccountRepository) ActivateByEmail(
 	email string,
 	optionalUow ...common.IUnitOfWork,
 ) (*entity.AccountEntity, error) {
+	where := builder.NewWhere[entity.AccountEntity]()
+	where.Equal(&where.Entity().Email, email)
+	update := builder.NewUpdate[entity.AccountEntity]()
+	update.Set(&update.Entity().DeletedAt, nil)
+
 	if _, err := r.databaseAdapter.Update(ctx, r.tableName,
-		builder.NewWhere[entity.AccountEntity]().
-			Equal(&r.entityType.Email, email).
-			ToJSON(),
-		builder.NewUpdate[entity.AccountEntity]().
-			Set(&r.entityType.DeletedAt, nil).
-			ToJSON(),
+		where.ToJSON(),
+		update.ToJSON(),
+		optionalUow...,
 	); err != nil {
 		return nil, err
 	}
@@ -85,7 +101,7 @@ func (r *PgxAccountRepository) ActivateByEmail(
 		r.databaseAdapter.FindOne(ctx, r.tableName,
 			builder.NewQuery[entity.AccountEntity]().
 				Where(func(e *entity.AccountEntity, q *builder.WhereBuilder[entity.AccountEntity]) {
-					q.Equal(&r.entityType.Email, email)
+					q.Equal(&e.Email, email)
 				}).
 				ToJSON(),
 			optionalUow...,
@@ -93,6 +109,29 @@ func (r *PgxAccountRepository) ActivateByEmail(
 	)
 }
 
+func (r *PgxAccountRepository) UpdateEmail(
+	ctx context.Context,
+	accountID uuid.UUID,
+	previous string,
+	email string,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountEntity]()
+	where.Equal(&where.Entity().ID, accountID.String()).
+		Equal(&where.Entity().Email, previous)
+	update := builder.NewUpdate[entity.AccountEntity]()
+	update.Set(&update.Entity().Email, email).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	affected, err := r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return 0, repository.ErrAccount_EmailTaken
+	}
+	return affected, err
+}
+
 func init() {
 	di.SingletonAs[repository.IAccountRepository](NewPgxAccountRepository)
 }
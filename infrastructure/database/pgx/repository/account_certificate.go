@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountCertificateRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountCertificateRepository = (*PgxAccountCertificateRepository)(nil)
+
+func NewPgxAccountCertificateRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountCertificateRepository {
+	return &PgxAccountCertificateRepository{
+		tableName:       `"control_plane"."account_certificate"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountCertificateRepository) Insert(
+	ctx context.Context,
+	certificate *entity.AccountCertificateEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(certificate)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccountCertificateRepository) GetByID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	certificateID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountCertificateEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountCertificateEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountCertificateEntity]().
+				Where(func(e *entity.AccountCertificateEntity, q *builder.WhereBuilder[entity.AccountCertificateEntity]) {
+					q.Equal(&e.ID, certificateID.String()).
+						Equal(&e.AccountID, accountID.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountCertificateRepository) ExistsByFingerprint(
+	ctx context.Context,
+	accountID uuid.UUID,
+	fingerprint string,
+	optionalUow ...common.IUnitOfWork,
+) (bool, error) {
+	count, err := r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.AccountCertificateEntity]().
+			Where(func(e *entity.AccountCertificateEntity, q *builder.WhereBuilder[entity.AccountCertificateEntity]) {
+				q.Equal(&e.AccountID, accountID.String()).
+					Equal(&e.Fingerprint, fingerprint)
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+	return count > 0, err
+}
+
+func (r *PgxAccountCertificateRepository) List(
+	ctx context.Context,
+	accountID uuid.UUID,
+	includeRevoked bool,
+	offset int64,
+	limit int64,
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.AccountCertificateEntity], error) {
+	return r.findMany(ctx, func(e *entity.AccountCertificateEntity, q *builder.WhereBuilder[entity.AccountCertificateEntity]) {
+		q.Equal(&e.AccountID, accountID.String())
+		if !includeRevoked {
+			q.Empty(&e.RevokedAt)
+		}
+	}, offset, limit, optionalUow...)
+}
+
+func (r *PgxAccountCertificateRepository) ListExpiring(
+	ctx context.Context,
+	accountID uuid.UUID,
+	from time.Time,
+	until time.Time,
+	offset int64,
+	limit int64,
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.AccountCertificateEntity], error) {
+	return r.findMany(ctx, func(e *entity.AccountCertificateEntity, q *builder.WhereBuilder[entity.AccountCertificateEntity]) {
+		q.Equal(&e.AccountID, accountID.String()).
+			Empty(&e.RevokedAt).
+			GreaterThan(&e.ExpiresAt, from.UTC()).
+			LowerEqual(&e.ExpiresAt, until.UTC())
+	}, offset, limit, optionalUow...)
+}
+
+func (r *PgxAccountCertificateRepository) findMany(
+	ctx context.Context,
+	where builder.WhereFn[entity.AccountCertificateEntity],
+	offset int64,
+	limit int64,
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.AccountCertificateEntity], error) {
+	query := builder.NewQuery[entity.AccountCertificateEntity]().
+		Where(where).
+		Sort(func(e *entity.AccountCertificateEntity, s *builder.SortBuilder[entity.AccountCertificateEntity]) {
+			s.Asc(&e.ExpiresAt)
+		}).
+		Offset(offset).
+		Limit(limit).
+		ToJSON()
+
+	raw, err := r.databaseAdapter.FindMany(ctx, r.tableName, query, optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+	result, err := builder.NewResultFromRaw[entity.AccountCertificateEntity](raw)
+	if err != nil || result == nil {
+		return result, err
+	}
+	for index := range result.Items {
+		result.Items[index] = result.Items[index].WithoutSecrets()
+	}
+	return result, nil
+}
+
+func (r *PgxAccountCertificateRepository) Revoke(
+	ctx context.Context,
+	accountID uuid.UUID,
+	certificateID uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountCertificateEntity]()
+	where.Equal(&where.Entity().ID, certificateID.String()).
+		Equal(&where.Entity().AccountID, accountID.String()).
+		Empty(&where.Entity().RevokedAt)
+	update := builder.NewUpdate[entity.AccountCertificateEntity]()
+	update.Set(&update.Entity().RevokedAt, at.UTC()).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountCertificateRepository) Delete(
+	ctx context.Context,
+	accountID uuid.UUID,
+	certificateID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountCertificateEntity]()
+	where.Equal(&where.Entity().ID, certificateID.String()).
+		Equal(&where.Entity().AccountID, accountID.String())
+
+	return r.databaseAdapter.Delete(ctx, r.tableName, where.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountCertificateRepository](NewPgxAccountCertificateRepository)
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountCredentialRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountCredentialRepository = (*PgxAccountCredentialRepository)(nil)
+
+func NewPgxAccountCredentialRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountCredentialRepository {
+	return &PgxAccountCredentialRepository{
+		tableName:       `"control_plane"."account_credential"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountCredentialRepository) GetByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	credentialType entity.AccountCredentialTypeEnum,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountCredentialEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountCredentialEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountCredentialEntity]().
+				Where(func(e *entity.AccountCredentialEntity, q *builder.WhereBuilder[entity.AccountCredentialEntity]) {
+					q.Equal(&e.AccountID, accountID.String()).
+						Equal(&e.CredentialType, string(credentialType)).
+						Empty(&e.DeletedAt)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountCredentialRepository) ListByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	credentialType entity.AccountCredentialTypeEnum,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.AccountCredentialEntity, error) {
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName,
+		builder.NewQuery[entity.AccountCredentialEntity]().
+			Where(func(e *entity.AccountCredentialEntity, q *builder.WhereBuilder[entity.AccountCredentialEntity]) {
+				q.Equal(&e.AccountID, accountID.String()).
+					Equal(&e.CredentialType, string(credentialType)).
+					Empty(&e.DeletedAt)
+			}).
+			Sort(func(e *entity.AccountCredentialEntity, s *builder.SortBuilder[entity.AccountCredentialEntity]) {
+				s.Asc(&e.CreatedAt)
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := builder.NewResultFromRaw[entity.AccountCredentialEntity](result)
+	if err != nil || credentials == nil {
+		return nil, err
+	}
+	return credentials.Items, nil
+}
+
+func (r *PgxAccountCredentialRepository) GetByProviderSubject(
+	ctx context.Context,
+	credentialType entity.AccountCredentialTypeEnum,
+	providerSubject string,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountCredentialEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountCredentialEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountCredentialEntity]().
+				Where(func(e *entity.AccountCredentialEntity, q *builder.WhereBuilder[entity.AccountCredentialEntity]) {
+					q.Equal(&e.CredentialType, string(credentialType)).
+						Equal(&e.ProviderSubject, providerSubject).
+						Empty(&e.DeletedAt)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountCredentialRepository) Insert(
+	ctx context.Context,
+	credential *entity.AccountCredentialEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccountCredentialRepository) UpdateSignCount(
+	ctx context.Context,
+	id uuid.UUID,
+	previous int64,
+	signCount int64,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountCredentialEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Equal(&where.Entity().SignCount, previous).
+		Empty(&where.Entity().DeletedAt)
+	update := builder.NewUpdate[entity.AccountCredentialEntity]()
+	update.Set(&update.Entity().SignCount, signCount).
+		Set(&update.Entity().LastUsedAt, at.UTC()).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountCredentialRepository) SoftDelete(
+	ctx context.Context,
+	id uuid.UUID,
+	accountID uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountCredentialEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Equal(&where.Entity().AccountID, accountID.String()).
+		Empty(&where.Entity().DeletedAt)
+	update := builder.NewUpdate[entity.AccountCredentialEntity]()
+	update.Set(&update.Entity().DeletedAt, at.UTC()).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountCredentialRepository](NewPgxAccountCredentialRepository)
+}
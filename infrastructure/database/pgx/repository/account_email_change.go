@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountEmailChangeRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountEmailChangeRepository = (*PgxAccountEmailChangeRepository)(nil)
+
+func NewPgxAccountEmailChangeRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountEmailChangeRepository {
+	return &PgxAccountEmailChangeRepository{
+		tableName:       `"control_plane"."account_email_change"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountEmailChangeRepository) Insert(
+	ctx context.Context,
+	change *entity.AccountEmailChangeEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccountEmailChangeRepository) GetByConfirmTokenHash(
+	ctx context.Context,
+	tokenHash string,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountEmailChangeEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountEmailChangeEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountEmailChangeEntity]().
+				Where(func(e *entity.AccountEmailChangeEntity, q *builder.WhereBuilder[entity.AccountEmailChangeEntity]) {
+					q.Equal(&e.ConfirmTokenHash, tokenHash)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountEmailChangeRepository) GetByCancelTokenHash(
+	ctx context.Context,
+	tokenHash string,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountEmailChangeEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountEmailChangeEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountEmailChangeEntity]().
+				Where(func(e *entity.AccountEmailChangeEntity, q *builder.WhereBuilder[entity.AccountEmailChangeEntity]) {
+					q.Equal(&e.CancelTokenHash, tokenHash)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountEmailChangeRepository) CancelPending(
+	ctx context.Context,
+	accountID uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountEmailChangeEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String()).
+		Empty(&where.Entity().ConfirmedAt).
+		Empty(&where.Entity().CancelledAt)
+	update := builder.NewUpdate[entity.AccountEmailChangeEntity]()
+	update.Set(&update.Entity().CancelledAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountEmailChangeRepository) Confirm(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountEmailChangeEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Empty(&where.Entity().ConfirmedAt).
+		Empty(&where.Entity().CancelledAt)
+	update := builder.NewUpdate[entity.AccountEmailChangeEntity]()
+	update.Set(&update.Entity().ConfirmedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountEmailChangeRepository) Cancel(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountEmailChangeEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Empty(&where.Entity().ConfirmedAt).
+		Empty(&where.Entity().CancelledAt)
+	update := builder.NewUpdate[entity.AccountEmailChangeEntity]()
+	update.Set(&update.Entity().CancelledAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountEmailChangeRepository](NewPgxAccountEmailChangeRepository)
+}
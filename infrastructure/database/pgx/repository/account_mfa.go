@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountMfaRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountMfaRepository = (*PgxAccountMfaRepository)(nil)
+
+func NewPgxAccountMfaRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountMfaRepository {
+	return &PgxAccountMfaRepository{
+		tableName:       `"control_plane"."account_mfa"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountMfaRepository) GetByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountMfaEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountMfaEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountMfaEntity]().
+				Where(func(e *entity.AccountMfaEntity, q *builder.WhereBuilder[entity.AccountMfaEntity]) {
+					q.Equal(&e.AccountID, accountID.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountMfaRepository) Insert(
+	ctx context.Context,
+	mfa *entity.AccountMfaEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(mfa)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccountMfaRepository) Confirm(
+	ctx context.Context,
+	accountID uuid.UUID,
+	step int64,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountMfaEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String()).
+		Empty(&where.Entity().ConfirmedAt)
+	update := builder.NewUpdate[entity.AccountMfaEntity]()
+	update.Set(&update.Entity().ConfirmedAt, at.UTC()).
+		Set(&update.Entity().LastUsedStep, step).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountMfaRepository) UseStep(
+	ctx context.Context,
+	accountID uuid.UUID,
+	step int64,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountMfaEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String()).
+		LowerThan(&where.Entity().LastUsedStep, step)
+	update := builder.NewUpdate[entity.AccountMfaEntity]()
+	update.Set(&update.Entity().LastUsedStep, step).
+		Set(&update.Entity().UpdatedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountMfaRepository) DeleteByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountMfaEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String())
+
+	return r.databaseAdapter.Delete(ctx, r.tableName, where.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountMfaRepository](NewPgxAccountMfaRepository)
+}
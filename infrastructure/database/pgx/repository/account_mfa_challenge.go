@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountMfaChallengeRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountMfaChallengeRepository = (*PgxAccountMfaChallengeRepository)(nil)
+
+func NewPgxAccountMfaChallengeRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountMfaChallengeRepository {
+	return &PgxAccountMfaChallengeRepository{
+		tableName:       `"control_plane"."account_mfa_challenge"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountMfaChallengeRepository) Insert(
+	ctx context.Context,
+	challenge *entity.AccountMfaChallengeEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccountMfaChallengeRepository) GetByTokenHash(
+	ctx context.Context,
+	tokenHash string,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.AccountMfaChallengeEntity, error) {
+	return database.TypedFromJsonWithErr[entity.AccountMfaChallengeEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.AccountMfaChallengeEntity]().
+				Where(func(e *entity.AccountMfaChallengeEntity, q *builder.WhereBuilder[entity.AccountMfaChallengeEntity]) {
+					q.Equal(&e.TokenHash, tokenHash)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxAccountMfaChallengeRepository) Attempt(
+	ctx context.Context,
+	challengeID uuid.UUID,
+	attempts int,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountMfaChallengeEntity]()
+	where.Equal(&where.Entity().ID, challengeID.String()).
+		Equal(&where.Entity().Attempts, attempts)
+	update := builder.NewUpdate[entity.AccountMfaChallengeEntity]()
+	update.Set(&update.Entity().Attempts, attempts+1)
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountMfaChallengeRepository) Delete(
+	ctx context.Context,
+	challengeID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountMfaChallengeEntity]()
+	where.Equal(&where.Entity().ID, challengeID.String())
+
+	return r.databaseAdapter.Delete(ctx, r.tableName, where.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountMfaChallengeRepository](NewPgxAccountMfaChallengeRepository)
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountRecoveryCodeRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountRecoveryCodeRepository = (*PgxAccountRecoveryCodeRepository)(nil)
+
+func NewPgxAccountRecoveryCodeRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountRecoveryCodeRepository {
+	return &PgxAccountRecoveryCodeRepository{
+		tableName:       `"control_plane"."account_recovery_code"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountRecoveryCodeRepository) Insert(
+	ctx context.Context,
+	codes []entity.AccountRecoveryCodeEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	if len(codes) == 0 {
+		return nil
+	}
+	rows := make([]json.RawMessage, 0, len(codes))
+	for index := range codes {
+		row, err := json.Marshal(&codes[index])
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, rows, optionalUow...)
+}
+
+func (r *PgxAccountRecoveryCodeRepository) CountUnused(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	return r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.AccountRecoveryCodeEntity]().
+			Where(func(e *entity.AccountRecoveryCodeEntity, q *builder.WhereBuilder[entity.AccountRecoveryCodeEntity]) {
+				q.Equal(&e.AccountID, accountID.String()).
+					Empty(&e.UsedAt)
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+}
+
+func (r *PgxAccountRecoveryCodeRepository) Use(
+	ctx context.Context,
+	accountID uuid.UUID,
+	codeHash string,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountRecoveryCodeEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String()).
+		Equal(&where.Entity().CodeHash, codeHash).
+		Empty(&where.Entity().UsedAt)
+	update := builder.NewUpdate[entity.AccountRecoveryCodeEntity]()
+	update.Set(&update.Entity().UsedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxAccountRecoveryCodeRepository) DeleteByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountRecoveryCodeEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String())
+
+	return r.databaseAdapter.Delete(ctx, r.tableName, where.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountRecoveryCodeRepository](NewPgxAccountRecoveryCodeRepository)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxAccountSessionRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IAccountSessionRepository = (*PgxAccountSessionRepository)(nil)
+
+func NewPgxAccountSessionRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxAccountSessionRepository {
+	return &PgxAccountSessionRepository{
+		tableName:       `"control_plane"."account_session"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxAccountSessionRepository) Insert(
+	ctx context.Context,
+	session *entity.AccountSessionEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxAccountSessionRepository) ListActiveByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.AccountSessionEntity, error) {
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName,
+		builder.NewQuery[entity.AccountSessionEntity]().
+			Where(func(e *entity.AccountSessionEntity, q *builder.WhereBuilder[entity.AccountSessionEntity]) {
+				q.Equal(&e.AccountID, accountID.String()).
+					Empty(&e.RevokedAt)
+			}).
+			Sort(func(e *entity.AccountSessionEntity, s *builder.SortBuilder[entity.AccountSessionEntity]) {
+				s.Desc(&e.CreatedAt)
+			}).
+			Limit(1000).
+			ToJSON(),
+		optionalUow...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := builder.NewResultFromRaw[entity.AccountSessionEntity](result)
+	if err != nil || sessions == nil {
+		return nil, err
+	}
+	return sessions.Items, nil
+}
+
+func (r *PgxAccountSessionRepository) Revoke(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.AccountSessionEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Empty(&where.Entity().RevokedAt)
+	update := builder.NewUpdate[entity.AccountSessionEntity]()
+	update.Set(&update.Entity().RevokedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IAccountSessionRepository](NewPgxAccountSessionRepository)
+}
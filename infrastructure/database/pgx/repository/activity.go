@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxActivityRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IActivityRepository = (*PgxActivityRepository)(nil)
+
+func NewPgxActivityRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxActivityRepository {
+	return &PgxActivityRepository{
+		tableName:       `"control_plane"."activity"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxActivityRepository) Insert(
+	ctx context.Context,
+	activity *entity.ActivityEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(activity)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxActivityRepository) Search(
+	ctx context.Context,
+	query *builder.Query[entity.ActivityEntity],
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.ActivityEntity], error) {
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query.ToJSON(), optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+	return builder.NewResultFromRaw[entity.ActivityEntity](result)
+}
+
+func init() {
+	di.SingletonAs[repository.IActivityRepository](NewPgxActivityRepository)
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxCurrencyRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.ICurrencyRepository = (*PgxCurrencyRepository)(nil)
+
+func NewPgxCurrencyRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxCurrencyRepository {
+	return &PgxCurrencyRepository{
+		tableName:       `"control_plane"."currency"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxCurrencyRepository) List(
+	ctx context.Context,
+	onlyActive bool,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.CurrencyEntity, error) {
+	query := builder.NewQuery[entity.CurrencyEntity]().
+		Where(func(e *entity.CurrencyEntity, q *builder.WhereBuilder[entity.CurrencyEntity]) {
+			if onlyActive {
+				q.Equal(&e.IsActive, true)
+			}
+		}).
+		Sort(func(e *entity.CurrencyEntity, s *builder.SortBuilder[entity.CurrencyEntity]) {
+			s.Asc(&e.Code)
+		}).
+		Limit(1000).
+		ToJSON()
+
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query, optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+
+	typed, err := builder.NewResultFromRaw[entity.CurrencyEntity](result)
+	if err != nil {
+		return nil, err
+	}
+	return typed.Items, nil
+}
+
+func (r *PgxCurrencyRepository) GetByCode(
+	ctx context.Context,
+	code string,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.CurrencyEntity, error) {
+	return database.TypedFromJsonWithErr[entity.CurrencyEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.CurrencyEntity]().
+				Where(func(e *entity.CurrencyEntity, q *builder.WhereBuilder[entity.CurrencyEntity]) {
+					q.Equal(&e.Code, strings.ToUpper(code))
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxCurrencyRepository) LockByCode(
+	ctx context.Context,
+	code string,
+	exclusive bool,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.CurrencyEntity, error) {
+	lock := builder.LockEnum_ForShare
+	if exclusive {
+		lock = builder.LockEnum_ForUpdate
+	}
+	return database.TypedFromJsonWithErr[entity.CurrencyEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.CurrencyEntity]().
+				Where(func(e *entity.CurrencyEntity, q *builder.WhereBuilder[entity.CurrencyEntity]) {
+					q.Equal(&e.Code, strings.ToUpper(code))
+				}).
+				Lock(lock).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxCurrencyRepository) Insert(
+	ctx context.Context,
+	currencies []entity.CurrencyEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	rows := make([]json.RawMessage, 0, len(currencies))
+	for index := range currencies {
+		row, err := json.Marshal(&currencies[index])
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, rows, optionalUow...)
+}
+
+func (r *PgxCurrencyRepository) Update(
+	ctx context.Context,
+	currency *entity.CurrencyEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	now := time.Now().UTC()
+	currency.UpdatedAt = &now
+
+	where := builder.NewWhere[entity.CurrencyEntity]()
+	where.Equal(&where.Entity().Code, strings.ToUpper(currency.Code))
+	update := builder.NewUpdate[entity.CurrencyEntity]()
+	update.Set(&update.Entity().Name, currency.Name).
+		Set(&update.Entity().MinorUnit, currency.MinorUnit).
+		Set(&update.Entity().Symbol, currency.Symbol).
+		Set(&update.Entity().IsActive, currency.IsActive).
+		Set(&update.Entity().UpdatedAt, currency.UpdatedAt)
+
+	_, err := r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+	return err
+}
+
+func init() {
+	di.SingletonAs[repository.ICurrencyRepository](NewPgxCurrencyRepository)
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxCurrencyExchangeRateRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.ICurrencyExchangeRateRepository = (*PgxCurrencyExchangeRateRepository)(nil)
+
+func NewPgxCurrencyExchangeRateRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxCurrencyExchangeRateRepository {
+	return &PgxCurrencyExchangeRateRepository{
+		tableName:       `"control_plane"."currency_exchange_rate"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxCurrencyExchangeRateRepository) Insert(
+	ctx context.Context,
+	rates []entity.CurrencyExchangeRateEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	rows := make([]json.RawMessage, 0, len(rates))
+	for index := range rates {
+		row, err := json.Marshal(&rates[index])
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, rows, optionalUow...)
+}
+
+func (r *PgxCurrencyExchangeRateRepository) Exists(
+	ctx context.Context,
+	baseCurrencyCode string,
+	quoteCurrencyCode string,
+	source entity.CurrencyExchangeRateSourceEnum,
+	effectiveAt time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (bool, error) {
+	count, err := r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.CurrencyExchangeRateEntity]().
+			Where(func(e *entity.CurrencyExchangeRateEntity, q *builder.WhereBuilder[entity.CurrencyExchangeRateEntity]) {
+				q.Equal(&e.BaseCurrencyCode, strings.ToUpper(baseCurrencyCode)).
+					Equal(&e.QuoteCurrencyCode, strings.ToUpper(quoteCurrencyCode)).
+					Equal(&e.Source, string(source)).
+					Equal(&e.EffectiveAt, effectiveAt.UTC())
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+	return count > 0, err
+}
+
+func (r *PgxCurrencyExchangeRateRepository) GetEffective(
+	ctx context.Context,
+	baseCurrencyCode string,
+	quoteCurrencyCode string,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.CurrencyExchangeRateEntity, error) {
+	latest, err := database.TypedFromJsonWithErr[entity.CurrencyExchangeRateEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.CurrencyExchangeRateEntity]().
+				Where(func(e *entity.CurrencyExchangeRateEntity, q *builder.WhereBuilder[entity.CurrencyExchangeRateEntity]) {
+					q.Equal(&e.BaseCurrencyCode, strings.ToUpper(baseCurrencyCode)).
+						Equal(&e.QuoteCurrencyCode, strings.ToUpper(quoteCurrencyCode)).
+						LowerEqual(&e.EffectiveAt, at.UTC())
+				}).
+				Sort(func(e *entity.CurrencyExchangeRateEntity, s *builder.SortBuilder[entity.CurrencyExchangeRateEntity]) {
+					s.Desc(&e.EffectiveAt)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+	if err != nil || latest == nil {
+		return latest, err
+	}
+
+	// the sort cannot break ties reliably, so every rate of that instant is
+	// loaded and the preferred one picked here
+	raw, err := r.databaseAdapter.FindMany(ctx, r.tableName,
+		builder.NewQuery[entity.CurrencyExchangeRateEntity]().
+			Where(func(e *entity.CurrencyExchangeRateEntity, q *builder.WhereBuilder[entity.CurrencyExchangeRateEntity]) {
+				q.Equal(&e.BaseCurrencyCode, strings.ToUpper(baseCurrencyCode)).
+					Equal(&e.QuoteCurrencyCode, strings.ToUpper(quoteCurrencyCode)).
+					Equal(&e.EffectiveAt, latest.EffectiveAt)
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tied, err := builder.NewResultFromRaw[entity.CurrencyExchangeRateEntity](raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range tied.Items {
+		if prefersRate(&tied.Items[index], latest) {
+			latest = &tied.Items[index]
+		}
+	}
+	return latest, nil
+}
+
+// prefersRate tells whether candidate wins over current for the same
+// instant: a MANUAL rate beats a PROVIDER one, then the latest recorded wins.
+func prefersRate(candidate *entity.CurrencyExchangeRateEntity, current *entity.CurrencyExchangeRateEntity) bool {
+	candidateManual := candidate.Source == entity.CurrencyExchangeRateSource_Manual
+	currentManual := current.Source == entity.CurrencyExchangeRateSource_Manual
+	if candidateManual != currentManual {
+		return candidateManual
+	}
+	return candidate.CreatedAt.After(current.CreatedAt)
+}
+
+func (r *PgxCurrencyExchangeRateRepository) ListHistory(
+	ctx context.Context,
+	baseCurrencyCode string,
+	quoteCurrencyCode string,
+	from *time.Time,
+	to *time.Time,
+	offset int64,
+	limit int64,
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.CurrencyExchangeRateEntity], error) {
+	query := builder.NewQuery[entity.CurrencyExchangeRateEntity]().
+		Where(func(e *entity.CurrencyExchangeRateEntity, q *builder.WhereBuilder[entity.CurrencyExchangeRateEntity]) {
+			q.Equal(&e.BaseCurrencyCode, strings.ToUpper(baseCurrencyCode)).
+				Equal(&e.QuoteCurrencyCode, strings.ToUpper(quoteCurrencyCode))
+			if from != nil {
+				q.GreaterEqual(&e.EffectiveAt, from.UTC())
+			}
+			if to != nil {
+				q.LowerEqual(&e.EffectiveAt, to.UTC())
+			}
+		}).
+		Sort(func(e *entity.CurrencyExchangeRateEntity, s *builder.SortBuilder[entity.CurrencyExchangeRateEntity]) {
+			s.Desc(&e.EffectiveAt)
+		}).
+		Offset(offset).
+		Limit(limit).
+		ToJSON()
+
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query, optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+	return builder.NewResultFromRaw[entity.CurrencyExchangeRateEntity](result)
+}
+
+func init() {
+	di.SingletonAs[repository.ICurrencyExchangeRateRepository](NewPgxCurrencyExchangeRateRepository)
+}
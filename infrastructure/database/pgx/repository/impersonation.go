@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxImpersonationRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IImpersonationRepository = (*PgxImpersonationRepository)(nil)
+
+func NewPgxImpersonationRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxImpersonationRepository {
+	return &PgxImpersonationRepository{
+		tableName:       `"control_plane"."impersonation"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxImpersonationRepository) Insert(
+	ctx context.Context,
+	impersonation *entity.ImpersonationEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(impersonation)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxImpersonationRepository) GetByID(
+	ctx context.Context,
+	id uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.ImpersonationEntity, error) {
+	return database.TypedFromJsonWithErr[entity.ImpersonationEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.ImpersonationEntity]().
+				Where(func(e *entity.ImpersonationEntity, q *builder.WhereBuilder[entity.ImpersonationEntity]) {
+					q.Equal(&e.ID, id.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxImpersonationRepository) End(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.ImpersonationEntity]()
+	where.Equal(&where.Entity().ID, id.String()).
+		Empty(&where.Entity().EndedAt)
+	update := builder.NewUpdate[entity.ImpersonationEntity]()
+	update.Set(&update.Entity().EndedAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.IImpersonationRepository](NewPgxImpersonationRepository)
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+// pgUniqueViolation is the SQLSTATE of an insert or update that breaks a
+// unique index.
+const pgUniqueViolation = "23505"
+
+type PgxInboxMessageRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IInboxMessageRepository = (*PgxInboxMessageRepository)(nil)
+
+func NewPgxInboxMessageRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxInboxMessageRepository {
+	return &PgxInboxMessageRepository{
+		tableName:       `"control_plane"."inbox_message"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxInboxMessageRepository) Insert(
+	ctx context.Context,
+	message *entity.InboxMessageEntity,
+	optionalUow ...common.IUnitOfWork,
+) (bool, error) {
+	processed, err := r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.InboxMessageEntity]().
+			Where(func(e *entity.InboxMessageEntity, q *builder.WhereBuilder[entity.InboxMessageEntity]) {
+				q.Equal(&e.MessageID, message.MessageID).
+					Equal(&e.Consumer, message.Consumer)
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+	if err != nil {
+		return false, err
+	}
+	if processed > 0 {
+		return false, nil
+	}
+
+	row, err := json.Marshal(message)
+	if err != nil {
+		return false, err
+	}
+	if err := r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...); err != nil {
+		// two consumers of the same message raced past the lookup
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func init() {
+	di.SingletonAs[repository.IInboxMessageRepository](NewPgxInboxMessageRepository)
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxMembershipRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IMembershipRepository = (*PgxMembershipRepository)(nil)
+
+func NewPgxMembershipRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxMembershipRepository {
+	return &PgxMembershipRepository{
+		tableName:       `"control_plane"."membership"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxMembershipRepository) ListActiveByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.MembershipEntity, error) {
+	return r.listActive(ctx, func(e *entity.MembershipEntity, q *builder.WhereBuilder[entity.MembershipEntity]) {
+		q.Equal(&e.AccountID, accountID.String())
+	}, optionalUow)
+}
+
+func (r *PgxMembershipRepository) ListActiveByTenantID(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.MembershipEntity, error) {
+	return r.listActive(ctx, func(e *entity.MembershipEntity, q *builder.WhereBuilder[entity.MembershipEntity]) {
+		q.Equal(&e.TenantID, tenantID.String())
+	}, optionalUow)
+}
+
+func (r *PgxMembershipRepository) LockActiveByTenantID(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) ([]entity.MembershipEntity, error) {
+	return r.listActive(ctx, func(e *entity.MembershipEntity, q *builder.WhereBuilder[entity.MembershipEntity]) {
+		q.Equal(&e.TenantID, tenantID.String())
+	}, optionalUow, builder.LockEnum_ForUpdate)
+}
+
+func (r *PgxMembershipRepository) UpdateRole(
+	ctx context.Context,
+	membershipID uuid.UUID,
+	role entity.MembershipRoleEnum,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.MembershipEntity]()
+	where.Equal(&where.Entity().ID, membershipID.String())
+	update := builder.NewUpdate[entity.MembershipEntity]()
+	update.Set(&update.Entity().Role, string(role)).
+		Set(&update.Entity().UpdatedAt, time.Now().UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxMembershipRepository) listActive(
+	ctx context.Context,
+	where builder.WhereFn[entity.MembershipEntity],
+	optionalUow []common.IUnitOfWork,
+	optionalLock ...builder.LockEnum,
+) ([]entity.MembershipEntity, error) {
+	query := builder.NewQuery[entity.MembershipEntity]().
+		Where(func(e *entity.MembershipEntity, q *builder.WhereBuilder[entity.MembershipEntity]) {
+			where(e, q)
+			q.Equal(&e.Status, string(entity.MembershipStatus_Active))
+		})
+	if len(optionalLock) > 0 {
+		query.Lock(optionalLock[0])
+	}
+
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query.ToJSON(), optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := builder.NewResultFromRaw[entity.MembershipEntity](result)
+	if err != nil || memberships == nil {
+		return nil, err
+	}
+	return memberships.Items, nil
+}
+
+func init() {
+	di.SingletonAs[repository.IMembershipRepository](NewPgxMembershipRepository)
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxNotificationRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.INotificationRepository = (*PgxNotificationRepository)(nil)
+
+func NewPgxNotificationRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxNotificationRepository {
+	return &PgxNotificationRepository{
+		tableName:       `"control_plane"."notification"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxNotificationRepository) Insert(
+	ctx context.Context,
+	notifications []entity.NotificationEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	rows := make([]json.RawMessage, 0, len(notifications))
+	for index := range notifications {
+		row, err := json.Marshal(&notifications[index])
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, rows, optionalUow...)
+}
+
+func (r *PgxNotificationRepository) Search(
+	ctx context.Context,
+	query *builder.Query[entity.NotificationEntity],
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.NotificationEntity], error) {
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query.ToJSON(), optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+	return builder.NewResultFromRaw[entity.NotificationEntity](result)
+}
+
+func (r *PgxNotificationRepository) CountUnread(
+	ctx context.Context,
+	accountID uuid.UUID,
+	tenantID *uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	return r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.NotificationEntity]().
+			Where(func(e *entity.NotificationEntity, q *builder.WhereBuilder[entity.NotificationEntity]) {
+				q.Equal(&e.AccountID, accountID.String()).
+					Empty(&e.ReadAt).
+					GreaterThan(&e.ExpiresAt, at.UTC())
+				if tenantID != nil {
+					q.Equal(&e.TenantID, tenantID.String())
+				}
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+}
+
+func (r *PgxNotificationRepository) MarkRead(
+	ctx context.Context,
+	accountID uuid.UUID,
+	notificationID uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.NotificationEntity]()
+	where.Equal(&where.Entity().ID, notificationID.String()).
+		Equal(&where.Entity().AccountID, accountID.String())
+	update := builder.NewUpdate[entity.NotificationEntity]()
+	update.Set(&update.Entity().ReadAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxNotificationRepository) MarkAllRead(
+	ctx context.Context,
+	accountID uuid.UUID,
+	tenantID *uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.NotificationEntity]()
+	where.Equal(&where.Entity().AccountID, accountID.String()).
+		Empty(&where.Entity().ReadAt)
+	if tenantID != nil {
+		where.Equal(&where.Entity().TenantID, tenantID.String())
+	}
+	update := builder.NewUpdate[entity.NotificationEntity]()
+	update.Set(&update.Entity().ReadAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxNotificationRepository) Delete(
+	ctx context.Context,
+	accountID uuid.UUID,
+	notificationID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.NotificationEntity]()
+	where.Equal(&where.Entity().ID, notificationID.String()).
+		Equal(&where.Entity().AccountID, accountID.String())
+
+	return r.databaseAdapter.Delete(ctx, r.tableName, where.ToJSON(), optionalUow...)
+}
+
+func init() {
+	di.SingletonAs[repository.INotificationRepository](NewPgxNotificationRepository)
+}
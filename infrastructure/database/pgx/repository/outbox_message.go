@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxOutboxMessageRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IOutboxMessageRepository = (*PgxOutboxMessageRepository)(nil)
+
+func NewPgxOutboxMessageRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxOutboxMessageRepository {
+	return &PgxOutboxMessageRepository{
+		tableName:       `"control_plane"."outbox_message"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxOutboxMessageRepository) Insert(
+	ctx context.Context,
+	message *entity.OutboxMessageEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxOutboxMessageRepository) pendingQuery() *builder.Query[entity.OutboxMessageEntity] {
+	return builder.NewQuery[entity.OutboxMessageEntity]().
+		Where(func(e *entity.OutboxMessageEntity, q *builder.WhereBuilder[entity.OutboxMessageEntity]) {
+			q.Empty(&e.SentAt)
+		}).
+		Sort(func(e *entity.OutboxMessageEntity, s *builder.SortBuilder[entity.OutboxMessageEntity]) {
+			// ids are uuid v7, so they sort by creation time
+			s.Asc(&e.ID)
+		})
+}
+
+func (r *PgxOutboxMessageRepository) ClaimPending(
+	ctx context.Context,
+	now time.Time,
+	limit int64,
+	optionalUow ...common.IUnitOfWork,
+) ([]*entity.OutboxMessageEntity, error) {
+	query := r.pendingQuery().
+		Where(func(e *entity.OutboxMessageEntity, q *builder.WhereBuilder[entity.OutboxMessageEntity]) {
+			q.LowerEqual(&e.AvailableAt, now.UTC())
+		}).
+		Limit(limit).
+		Lock(builder.LockEnum_ForUpdateSkipLocked).
+		ToJSON()
+
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query, optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := builder.NewResultFromRaw[entity.OutboxMessageEntity](result)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*entity.OutboxMessageEntity, 0, len(typed.Items))
+	for i := range typed.Items {
+		messages = append(messages, &typed.Items[i])
+	}
+	return messages, nil
+}
+
+func (r *PgxOutboxMessageRepository) MarkSent(
+	ctx context.Context,
+	id uuid.UUID,
+	at time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.OutboxMessageEntity]()
+	where.Equal(&where.Entity().ID, id.String())
+	update := builder.NewUpdate[entity.OutboxMessageEntity]()
+	update.Set(&update.Entity().SentAt, at.UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxOutboxMessageRepository) Reschedule(
+	ctx context.Context,
+	id uuid.UUID,
+	attempts int,
+	availableAt time.Time,
+	lastError string,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.OutboxMessageEntity]()
+	where.Equal(&where.Entity().ID, id.String())
+	update := builder.NewUpdate[entity.OutboxMessageEntity]()
+	update.Set(&update.Entity().Attempts, attempts).
+		Set(&update.Entity().AvailableAt, availableAt.UTC()).
+		Set(&update.Entity().LastError, lastError)
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxOutboxMessageRepository) DeleteSentBefore(
+	ctx context.Context,
+	before time.Time,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.OutboxMessageEntity]()
+	where.NotEmpty(&where.Entity().SentAt).
+		LowerThan(&where.Entity().SentAt, before.UTC())
+
+	return r.databaseAdapter.Delete(ctx, r.tableName, where.ToJSON(), optionalUow...)
+}
+
+func (r *PgxOutboxMessageRepository) CountPending(
+	ctx context.Context,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	return r.databaseAdapter.Count(ctx, r.tableName, r.pendingQuery().ToJSON(), optionalUow...)
+}
+
+func (r *PgxOutboxMessageRepository) GetOldestPending(
+	ctx context.Context,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.OutboxMessageEntity, error) {
+	return database.TypedFromJsonWithErr[entity.OutboxMessageEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName, r.pendingQuery().ToJSON(), optionalUow...),
+	)
+}
+
+func init() {
+	di.SingletonAs[repository.IOutboxMessageRepository](NewPgxOutboxMessageRepository)
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxPlatformAdminRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.IPlatformAdminRepository = (*PgxPlatformAdminRepository)(nil)
+
+func NewPgxPlatformAdminRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxPlatformAdminRepository {
+	return &PgxPlatformAdminRepository{
+		tableName:       `"control_plane"."platform_admin"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxPlatformAdminRepository) GetActiveByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.PlatformAdminEntity, error) {
+	return database.TypedFromJsonWithErr[entity.PlatformAdminEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.PlatformAdminEntity]().
+				Where(func(e *entity.PlatformAdminEntity, q *builder.WhereBuilder[entity.PlatformAdminEntity]) {
+					q.Equal(&e.AccountID, accountID.String()).
+						Empty(&e.RevokedAt)
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func init() {
+	di.SingletonAs[repository.IPlatformAdminRepository](NewPgxPlatformAdminRepository)
+}
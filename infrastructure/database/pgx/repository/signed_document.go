@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxSignedDocumentRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.ISignedDocumentRepository = (*PgxSignedDocumentRepository)(nil)
+
+func NewPgxSignedDocumentRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxSignedDocumentRepository {
+	return &PgxSignedDocumentRepository{
+		tableName:       `"control_plane"."signed_document"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxSignedDocumentRepository) Insert(
+	ctx context.Context,
+	document *entity.SignedDocumentEntity,
+	optionalUow ...common.IUnitOfWork,
+) error {
+	row, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	return r.databaseAdapter.Insert(ctx, r.tableName, []json.RawMessage{row}, optionalUow...)
+}
+
+func (r *PgxSignedDocumentRepository) GetByID(
+	ctx context.Context,
+	documentID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.SignedDocumentEntity, error) {
+	return database.TypedFromJsonWithErr[entity.SignedDocumentEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.SignedDocumentEntity]().
+				Where(func(e *entity.SignedDocumentEntity, q *builder.WhereBuilder[entity.SignedDocumentEntity]) {
+					q.Equal(&e.ID, documentID.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxSignedDocumentRepository) Search(
+	ctx context.Context,
+	query *builder.Query[entity.SignedDocumentEntity],
+	optionalUow ...common.IUnitOfWork,
+) (*builder.Result[entity.SignedDocumentEntity], error) {
+	result, err := r.databaseAdapter.FindMany(ctx, r.tableName, query.ToJSON(), optionalUow...)
+	if err != nil {
+		return nil, err
+	}
+	return builder.NewResultFromRaw[entity.SignedDocumentEntity](result)
+}
+
+func init() {
+	di.SingletonAs[repository.ISignedDocumentRepository](NewPgxSignedDocumentRepository)
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/database"
+	"src/core/builder"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+type PgxTenantConfigurationRepository struct {
+	tableName       string
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ repository.ITenantConfigurationRepository = (*PgxTenantConfigurationRepository)(nil)
+
+func NewPgxTenantConfigurationRepository(
+	databaseAdapter database.IDatabaseAdapter,
+) *PgxTenantConfigurationRepository {
+	return &PgxTenantConfigurationRepository{
+		tableName:       `"control_plane"."tenant_configuration"`,
+		databaseAdapter: databaseAdapter,
+	}
+}
+
+func (r *PgxTenantConfigurationRepository) CountByDefaultCurrencyCode(
+	ctx context.Context,
+	currencyCode string,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	return r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.TenantConfigurationEntity]().
+			Where(func(e *entity.TenantConfigurationEntity, q *builder.WhereBuilder[entity.TenantConfigurationEntity]) {
+				q.Equal(&e.DefaultCurrencyCode, strings.ToUpper(currencyCode))
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+}
+
+func (r *PgxTenantConfigurationRepository) GetByTenantID(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (*entity.TenantConfigurationEntity, error) {
+	return database.TypedFromJsonWithErr[entity.TenantConfigurationEntity](
+		r.databaseAdapter.FindOne(ctx, r.tableName,
+			builder.NewQuery[entity.TenantConfigurationEntity]().
+				Where(func(e *entity.TenantConfigurationEntity, q *builder.WhereBuilder[entity.TenantConfigurationEntity]) {
+					q.Equal(&e.TenantID, tenantID.String())
+				}).
+				ToJSON(),
+			optionalUow...,
+		),
+	)
+}
+
+func (r *PgxTenantConfigurationRepository) Update(
+	ctx context.Context,
+	configuration *entity.TenantConfigurationEntity,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	where := builder.NewWhere[entity.TenantConfigurationEntity]()
+	where.Equal(&where.Entity().TenantID, configuration.TenantID.String())
+	update := builder.NewUpdate[entity.TenantConfigurationEntity]()
+	update.Set(&update.Entity().DefaultTimezone, configuration.DefaultTimezone).
+		Set(&update.Entity().DefaultCurrencyCode, strings.ToUpper(configuration.DefaultCurrencyCode)).
+		Set(&update.Entity().RequireMfa, configuration.RequireMfa).
+		Set(&update.Entity().UpdatedAt, time.Now().UTC())
+
+	return r.databaseAdapter.Update(ctx, r.tableName, where.ToJSON(), update.ToJSON(), optionalUow...)
+}
+
+func (r *PgxTenantConfigurationRepository) CountRequiringMfa(
+	ctx context.Context,
+	tenantIDs []uuid.UUID,
+	optionalUow ...common.IUnitOfWork,
+) (int64, error) {
+	if len(tenantIDs) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, 0, len(tenantIDs))
+	for _, tenantID := range tenantIDs {
+		ids = append(ids, tenantID.String())
+	}
+	return r.databaseAdapter.Count(ctx, r.tableName,
+		builder.NewQuery[entity.TenantConfigurationEntity]().
+			Where(func(e *entity.TenantConfigurationEntity, q *builder.WhereBuilder[entity.TenantConfigurationEntity]) {
+				q.In(&e.TenantID, ids).
+					Equal(&e.RequireMfa, true)
+			}).
+			ToJSON(),
+		optionalUow...,
+	)
+}
+
+func init() {
+	di.SingletonAs[repository.ITenantConfigurationRepository](NewPgxTenantConfigurationRepository)
+}
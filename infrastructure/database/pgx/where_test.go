@@ -0,0 +1,146 @@
+package pgx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"src/core/builder"
+)
+
+type whereTestAmount struct {
+	Amount       int64  `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+func (a whereTestAmount) WhereMember() string {
+	return "amount"
+}
+
+func (a whereTestAmount) WhereValue() any {
+	return a.Amount
+}
+
+func (a whereTestAmount) WhereScope() map[string]any {
+	return map[string]any{"currency_code": a.CurrencyCode}
+}
+
+type whereTestEntity struct {
+	Status   string          `json:"status"`
+	Plan     string          `json:"plan"`
+	Seats    int             `json:"seats"`
+	Tags     []string        `json:"tags"`
+	Metadata map[string]any  `json:"metadata"`
+	Total    whereTestAmount `json:"total"`
+}
+
+type whereTestFn = builder.WhereFn[whereTestEntity]
+type whereTestBuilder = builder.WhereBuilder[whereTestEntity]
+
+func TestWhereConditions(t *testing.T) {
+	tests := []struct {
+		name  string
+		where whereTestFn
+		sql   string
+		args  []any
+	}{
+		{
+			name: "conditions",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Equal(&e.Status, "ACTIVE").GreaterThan(&e.Seats, 5)
+			},
+			sql:  `(data->>'seats')::numeric > $1 AND data->>'status' = $2`,
+			args: []any{5, "ACTIVE"},
+		},
+		{
+			name: "or keeps every alternative",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Or(func(e *whereTestEntity, w *whereTestBuilder) {
+					w.Equal(&e.Status, "A").Equal(&e.Status, "B")
+				})
+			},
+			sql:  `((data->>'status' = $1) OR data->>'status' = $2)`,
+			args: []any{"B", "A"},
+		},
+		{
+			name: "or of and groups",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Equal(&e.Plan, "PRO").Or(func(e *whereTestEntity, w *whereTestBuilder) {
+					w.And(func(e *whereTestEntity, w *whereTestBuilder) {
+						w.Equal(&e.Status, "TRIAL").LowerEqual(&e.Seats, 3)
+					}).Equal(&e.Status, "ACTIVE")
+				})
+			},
+			sql:  `(((data->>'seats')::numeric <= $1 AND data->>'status' = $2) OR data->>'status' = $3) AND data->>'plan' = $4`,
+			args: []any{3, "TRIAL", "ACTIVE", "PRO"},
+		},
+		{
+			name: "not",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Not(func(e *whereTestEntity, w *whereTestBuilder) {
+					w.Equal(&e.Status, "ACTIVE").In(&e.Plan, []string{"FREE", "TRIAL"})
+				})
+			},
+			sql:  `NOT (data->>'plan' = ANY($1) AND data->>'status' = $2)`,
+			args: []any{[]string{"FREE", "TRIAL"}, "ACTIVE"},
+		},
+		{
+			name: "dotted path",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Equal(w.Path(&e.Metadata, "plan", "tier"), "pro")
+			},
+			sql:  `data#>>'{"metadata","plan","tier"}' = $1`,
+			args: []any{"pro"},
+		},
+		{
+			name: "containment",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Contains(&e.Metadata, map[string]any{"plan": "pro"}).ArrayContains(&e.Tags, "beta")
+			},
+			sql:  `data->'metadata' @> $1::jsonb AND data->'tags' @> $2::jsonb`,
+			args: []any{`{"plan":"pro"}`, `["beta"]`},
+		},
+		{
+			name: "negated scoped operand keeps its scope",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.NotEqual(&e.Total, whereTestAmount{Amount: 100, CurrencyCode: "USD"})
+			},
+			sql:  `((data#>>'{"total","amount"}')::numeric <> $1 AND data#>>'{"total","currency_code"}' = $2)`,
+			args: []any{int64(100), "USD"},
+		},
+		{
+			name: "parameters are never inlined",
+			where: func(e *whereTestEntity, w *whereTestBuilder) {
+				w.Equal(&e.Status, "x' OR '1'='1")
+			},
+			sql:  `data->>'status' = $1`,
+			args: []any{"x' OR '1'='1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			where := builder.NewWhere[whereTestEntity]()
+			test.where(where.Entity(), where)
+
+			conditions := &whereConditions{next: 1}
+			parts, err := conditions.build(where.PointerMap)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sql := strings.Join(parts, " AND "); sql != test.sql {
+				t.Fatalf("got  %s\nwant %s", sql, test.sql)
+			}
+			if !reflect.DeepEqual(conditions.args, test.args) {
+				t.Fatalf("got args %#v, want %#v", conditions.args, test.args)
+			}
+		})
+	}
+}
+
+func TestWhereConditionsRejectsUnknownGroup(t *testing.T) {
+	where := builder.WherePointerMap{"$1": {"xor": builder.WherePointerMap{"status": {builder.WhereEnum_Equal: "A"}}}}
+	if _, err := (&whereConditions{next: 1}).build(where); err == nil {
+		t.Fatal("an unknown group operator was accepted")
+	}
+}
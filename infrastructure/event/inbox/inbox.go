@@ -0,0 +1,62 @@
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/database"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+// DatabaseEventInbox runs each asynchronous handler in a transaction that
+// also records the message as processed by it, so the record only exists if
+// the handler's changes were committed.
+type DatabaseEventInbox struct {
+	repository      repository.IInboxMessageRepository
+	databaseAdapter database.IDatabaseAdapter
+}
+
+var _ cqrs.IEventInbox = (*DatabaseEventInbox)(nil)
+
+func NewDatabaseEventInbox(
+	repository repository.IInboxMessageRepository,
+	databaseAdapter database.IDatabaseAdapter,
+) *DatabaseEventInbox {
+	return &DatabaseEventInbox{repository: repository, databaseAdapter: databaseAdapter}
+}
+
+func (i *DatabaseEventInbox) Process(
+	ctx context.Context,
+	consumer string,
+	messageID string,
+	handle func(ctx context.Context) error,
+) error {
+	uow, err := i.databaseAdapter.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback(ctx) // no-op once committed
+
+	first, err := i.repository.Insert(ctx, &entity.InboxMessageEntity{
+		MessageID:   messageID,
+		Consumer:    consumer,
+		ProcessedAt: time.Now().UTC(),
+	}, uow)
+	if err != nil || !first {
+		return err
+	}
+
+	// events published by the handler are released once it committed, as
+	// for a transactional command
+	txCtx, queue := cqrs.WithEventQueue(common.WithUnitOfWork(ctx, uow))
+	if err := handle(txCtx); err != nil {
+		return err
+	}
+	if err := uow.Commit(ctx); err != nil {
+		return err
+	}
+	return queue.Release(ctx)
+}
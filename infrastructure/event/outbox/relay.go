@@ -0,0 +1,199 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"src/application/adapter/database"
+	"src/application/adapter/logger"
+	"src/application/adapter/metrics"
+	adapter "src/application/adapter/outbox"
+	"src/application/adapter/stream"
+	"src/core/common"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+// OutboxRelay polls the outbox and publishes pending messages to the stream.
+// Every poll claims a batch with FOR UPDATE SKIP LOCKED in its own
+// transaction, so replicas share the work without publishing the same row
+// twice. A message may still be published again when the commit that marks
+// it sent fails: delivery is at least once.
+type OutboxRelay struct {
+	config          *adapter.OutboxConfig
+	repository      repository.IOutboxMessageRepository
+	databaseAdapter database.IDatabaseAdapter
+	stream          stream.IStreamAdapter
+	logger          logger.ILoggerAdapter
+	metrics         metrics.IMetricsAdapter
+}
+
+var _ adapter.IOutboxRelay = (*OutboxRelay)(nil)
+
+func NewOutboxRelay(
+	config *adapter.OutboxConfig,
+	repository repository.IOutboxMessageRepository,
+	databaseAdapter database.IDatabaseAdapter,
+	stream stream.IStreamAdapter,
+	logger logger.ILoggerAdapter,
+	metrics metrics.IMetricsAdapter,
+) *OutboxRelay {
+	return &OutboxRelay{
+		config:          config,
+		repository:      repository,
+		databaseAdapter: databaseAdapter,
+		stream:          stream,
+		logger:          logger,
+		metrics:         metrics,
+	}
+}
+
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+	prune := time.NewTicker(r.config.PruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-prune.C:
+			r.prune(ctx)
+			r.reportLag(ctx)
+		case <-poll.C:
+			// a batch started is finished even if ctx is done meanwhile
+			relayed, err := r.relayBatch(context.WithoutCancel(ctx))
+			if err != nil {
+				r.logger.Error("Outbox relay failed", map[string]any{"err": err.Error()})
+			}
+			// a full batch means more is probably waiting
+			wait := r.config.PollInterval
+			if err == nil && relayed == r.config.BatchSize {
+				wait = 0
+			}
+			poll.Reset(wait)
+		}
+	}
+}
+
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	uow, err := r.databaseAdapter.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer uow.Rollback(ctx) // no-op once committed
+
+	messages, err := r.repository.ClaimPending(ctx, time.Now(), int64(r.config.BatchSize), uow)
+	if err != nil {
+		return 0, err
+	}
+
+	// once a message failed, the later ones with its topic and key wait for
+	// its retry instead of overtaking it
+	type orderKey struct{ topic, key string }
+	held := map[orderKey]*entity.OutboxMessageEntity{}
+	for _, message := range messages {
+		order := orderKey{message.Topic, message.Key}
+		if failed, ok := held[order]; ok {
+			cause := "waiting for outbox message " + failed.ID.String()
+			if _, err := r.repository.Reschedule(ctx, message.ID, message.Attempts, failed.AvailableAt, cause, uow); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err := r.publish(ctx, message); err != nil {
+			if _, err := r.reschedule(ctx, message, err, uow); err != nil {
+				return 0, err
+			}
+			held[order] = message
+			continue
+		}
+		if _, err := r.repository.MarkSent(ctx, message.ID, time.Now(), uow); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := uow.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return len(messages), nil
+}
+
+// publish gives up after PublishTimeout, since the claimed rows stay locked
+// until the whole batch is done.
+func (r *OutboxRelay) publish(ctx context.Context, message *entity.OutboxMessageEntity) error {
+	ctx, cancel := context.WithTimeout(ctx, r.config.PublishTimeout)
+	defer cancel()
+	key := message.Key
+	return r.stream.Publish(ctx, message.Topic, stream.Payload{Message: message.Payload, Key: &key})
+}
+
+// reschedule counts a failed attempt and sets AvailableAt on message to its
+// next retry.
+func (r *OutboxRelay) reschedule(ctx context.Context, message *entity.OutboxMessageEntity, cause error, uow common.IUnitOfWork) (int64, error) {
+	attempts := message.Attempts + 1
+	availableAt := time.Now().Add(r.backoff(attempts))
+	message.AvailableAt = availableAt
+
+	r.logger.Warn("Outbox message not published", map[string]any{
+		"id":           message.ID.String(),
+		"type":         message.Type,
+		"attempts":     attempts,
+		"available_at": availableAt.UTC(),
+		"err":          cause.Error(),
+	})
+	return r.repository.Reschedule(ctx, message.ID, attempts, availableAt, cause.Error(), uow)
+}
+
+// backoff doubles the delay on every attempt, up to MaxBackoff.
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.config.MinBackoff
+	for i := 1; i < attempts && delay < r.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.config.MaxBackoff)
+}
+
+func (r *OutboxRelay) prune(ctx context.Context) {
+	deleted, err := r.repository.DeleteSentBefore(ctx, time.Now().Add(-r.config.Retention))
+	if err != nil {
+		r.logger.Error("Outbox prune failed", map[string]any{"err": err.Error()})
+		return
+	}
+	if deleted > 0 {
+		r.logger.Debug("Outbox pruned", map[string]any{"deleted": deleted})
+	}
+}
+
+// reportLag exports how many messages wait and for how long the oldest did,
+// and logs it as a warning past LagWarning.
+func (r *OutboxRelay) reportLag(ctx context.Context) {
+	pending, err := r.repository.CountPending(ctx)
+	if err != nil {
+		r.logger.Error("Outbox lag report failed", map[string]any{"err": err.Error()})
+		return
+	}
+	oldest, err := r.repository.GetOldestPending(ctx)
+	if err != nil {
+		r.logger.Error("Outbox lag report failed", map[string]any{"err": err.Error()})
+		return
+	}
+
+	var lag time.Duration
+	if oldest != nil {
+		lag = time.Since(oldest.CreatedAt)
+	}
+	r.metrics.SetGauge("outbox_pending_messages", "Outbox messages not published yet.", float64(pending))
+	r.metrics.SetGauge("outbox_oldest_pending_age_seconds", "Age of the oldest outbox message not published yet.", lag.Seconds())
+	optionalMap := map[string]any{
+		"pending": pending,
+		"lag_ms":  lag.Milliseconds(),
+	}
+	if lag > r.config.LagWarning {
+		r.logger.Warn("Outbox lag", optionalMap)
+		return
+	}
+	r.logger.Debug("Outbox lag", optionalMap)
+}
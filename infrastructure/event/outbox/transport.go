@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"src/core/cqrs"
+	"src/domain/entity"
+	"src/domain/repository"
+)
+
+// OutboxEventTransport writes published envelopes to the outbox, in the unit
+// of work carried by the context if any, so an event is only relayed to the
+// stream when the change that raised it committed. Envelopes are received
+// through the wrapped transport.
+type OutboxEventTransport struct {
+	topic      string
+	repository repository.IOutboxMessageRepository
+	subscriber cqrs.IEventTransport
+}
+
+var _ cqrs.IEventTransport = (*OutboxEventTransport)(nil)
+
+func NewOutboxEventTransport(
+	topic string,
+	repository repository.IOutboxMessageRepository,
+	subscriber cqrs.IEventTransport,
+) *OutboxEventTransport {
+	if topic == "" {
+		panic("event/outbox: topic is empty")
+	}
+	return &OutboxEventTransport{topic: topic, repository: repository, subscriber: subscriber}
+}
+
+func (t *OutboxEventTransport) Publish(ctx context.Context, envelope cqrs.EventEnvelope) error {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	return t.repository.Insert(ctx, &entity.OutboxMessageEntity{
+		ID:          id,
+		CreatedAt:   now,
+		AvailableAt: now,
+		Topic:       t.topic,
+		Key:         envelope.Type, // keeps each event type in order, as the stream transport does
+		Type:        envelope.Type,
+		Payload:     data,
+	})
+}
+
+func (t *OutboxEventTransport) Subscribe(ctx context.Context, deliver func(ctx context.Context, envelope cqrs.EventEnvelope) error) error {
+	return t.subscriber.Subscribe(ctx, deliver)
+}
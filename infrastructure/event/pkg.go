@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"src/application/adapter/database"
+	"src/application/adapter/logger"
+	"src/application/adapter/metrics"
+	adapter "src/application/adapter/outbox"
+	"src/application/adapter/stream"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/core/env"
+	"src/domain/repository"
+	"src/infrastructure/event/inbox"
+	"src/infrastructure/event/outbox"
+	impl "src/infrastructure/event/stream"
+)
+
+func init() {
+	di.SingletonAs[cqrs.IEventTransport](func(
+		outboxRepository repository.IOutboxMessageRepository,
+		streamAdapter stream.IStreamAdapter,
+	) cqrs.IEventTransport {
+		topic := env.Get("EVENT_TOPIC", "control_plane.event")
+		group := env.Get("EVENT_CONSUMER_GROUP", "control_plane")
+		return outbox.NewOutboxEventTransport(topic, outboxRepository, impl.NewStreamEventTransport(topic, group, streamAdapter))
+	})
+
+	di.SingletonAs[cqrs.IEventInbox](func(
+		inboxRepository repository.IInboxMessageRepository,
+		databaseAdapter database.IDatabaseAdapter,
+	) cqrs.IEventInbox {
+		return inbox.NewDatabaseEventInbox(inboxRepository, databaseAdapter)
+	})
+
+	di.SingletonAs[adapter.IOutboxRelay](func(
+		outboxRepository repository.IOutboxMessageRepository,
+		databaseAdapter database.IDatabaseAdapter,
+		streamAdapter stream.IStreamAdapter,
+		loggerAdapter logger.ILoggerAdapter,
+		metricsAdapter metrics.IMetricsAdapter,
+	) adapter.IOutboxRelay {
+		config := &adapter.OutboxConfig{
+			PollInterval:   env.Get("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:      env.Get("OUTBOX_BATCH_SIZE", 100),
+			PublishTimeout: env.Get("OUTBOX_PUBLISH_TIMEOUT", 10*time.Second),
+			MinBackoff:     env.Get("OUTBOX_MIN_BACKOFF", time.Second),
+			MaxBackoff:     env.Get("OUTBOX_MAX_BACKOFF", 5*time.Minute),
+			Retention:      env.Get("OUTBOX_RETENTION", 7*24*time.Hour),
+			PruneInterval:  env.Get("OUTBOX_PRUNE_INTERVAL", time.Minute),
+			LagWarning:     env.Get("OUTBOX_LAG_WARNING", time.Minute),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return outbox.NewOutboxRelay(config, outboxRepository, databaseAdapter, streamAdapter, loggerAdapter, metricsAdapter)
+	})
+}
@@ -0,0 +1,49 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+
+	stream "src/application/adapter/stream"
+	"src/core/cqrs"
+)
+
+// StreamEventTransport carries event envelopes as JSON messages on one
+// stream topic, keyed by event type so each type keeps its order. Replicas
+// subscribe in one consumer group, so each envelope is handled once.
+type StreamEventTransport struct {
+	topic  string
+	group  string
+	stream stream.IStreamAdapter
+}
+
+var _ cqrs.IEventTransport = (*StreamEventTransport)(nil)
+
+func NewStreamEventTransport(topic string, group string, streamAdapter stream.IStreamAdapter) *StreamEventTransport {
+	if topic == "" {
+		panic("event/stream: topic is empty")
+	}
+	if group == "" {
+		panic("event/stream: group is empty")
+	}
+	return &StreamEventTransport{topic: topic, group: group, stream: streamAdapter}
+}
+
+func (t *StreamEventTransport) Publish(ctx context.Context, envelope cqrs.EventEnvelope) error {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	key := envelope.Type
+	return t.stream.Publish(ctx, t.topic, stream.Payload{Message: data, Key: &key})
+}
+
+func (t *StreamEventTransport) Subscribe(ctx context.Context, deliver func(ctx context.Context, envelope cqrs.EventEnvelope) error) error {
+	return t.stream.Subscribe(ctx, t.topic, t.group, func(payload stream.Payload) error {
+		var envelope cqrs.EventEnvelope
+		if err := json.Unmarshal(payload.Message, &envelope); err != nil {
+			return nil // never decodes, retrying would only block the partition
+		}
+		return deliver(ctx, envelope)
+	})
+}
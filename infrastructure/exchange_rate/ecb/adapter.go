@@ -0,0 +1,128 @@
+package ecb
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	adapter "src/application/adapter/exchange_rate"
+	"src/domain/entity"
+)
+
+// ECB reference rates are always quoted against the euro.
+const baseCurrencyCode = "EUR"
+
+type ecbEnvelope struct {
+	Days []ecbDay `xml:"Cube>Cube"`
+}
+
+type ecbDay struct {
+	Time  string    `xml:"time,attr"`
+	Rates []ecbRate `xml:"Cube"`
+}
+
+type ecbRate struct {
+	Currency string `xml:"currency,attr"`
+	Rate     string `xml:"rate,attr"`
+}
+
+type EcbExchangeRateProvider struct {
+	config *adapter.ExchangeRateConfig
+	client *http.Client
+}
+
+var _ adapter.IExchangeRateProvider = (*EcbExchangeRateProvider)(nil)
+
+func NewEcbExchangeRateProvider(config *adapter.ExchangeRateConfig) *EcbExchangeRateProvider {
+	if config == nil {
+		panic("exchange_rate/ecb: config is nil")
+	}
+	return &EcbExchangeRateProvider{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (p *EcbExchangeRateProvider) Config() *adapter.ExchangeRateConfig {
+	return p.config
+}
+
+func (p *EcbExchangeRateProvider) Name() string {
+	return "ecb"
+}
+
+func (p *EcbExchangeRateProvider) Fetch(ctx context.Context) ([]adapter.ProvidedRate, error) {
+	reader, err := p.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return Parse(reader)
+}
+
+func (p *EcbExchangeRateProvider) open(ctx context.Context) (io.ReadCloser, error) {
+	source := strings.TrimSpace(p.config.ECBSource)
+
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		file, err := os.Open(source)
+		if err != nil {
+			return nil, fmt.Errorf("exchange_rate/ecb: open %q: %w", source, err)
+		}
+		return file, nil
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("exchange_rate/ecb: fetch %q: %w", source, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("exchange_rate/ecb: fetch %q: unexpected status %d", source, response.StatusCode)
+	}
+
+	return response.Body, nil
+}
+
+// Parse reads the ECB eurofxref XML format (daily, 90-day or full history
+// files) and returns one EUR-based rate per currency and day.
+func Parse(reader io.Reader) ([]adapter.ProvidedRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(reader).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("exchange_rate/ecb: invalid XML: %w", err)
+	}
+
+	var rates []adapter.ProvidedRate
+	for _, day := range envelope.Days {
+		effectiveAt, err := time.Parse(time.DateOnly, day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("exchange_rate/ecb: invalid day %q: %w", day.Time, err)
+		}
+
+		for _, item := range day.Rates {
+			rate, err := entity.ParseRate(item.Rate)
+			if err != nil {
+				return nil, fmt.Errorf("exchange_rate/ecb: %s on %s: %w", item.Currency, day.Time, err)
+			}
+
+			rates = append(rates, adapter.ProvidedRate{
+				BaseCurrencyCode:  baseCurrencyCode,
+				QuoteCurrencyCode: strings.ToUpper(item.Currency),
+				Rate:              rate,
+				EffectiveAt:       effectiveAt.UTC(),
+			})
+		}
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,21 @@
+package exchange_rate
+
+import (
+	adapter "src/application/adapter/exchange_rate"
+	"src/core/di"
+	"src/core/env"
+	impl "src/infrastructure/exchange_rate/ecb"
+)
+
+func init() {
+	di.RegisterAs[adapter.IExchangeRateProvider](func() adapter.IExchangeRateProvider {
+		config := &adapter.ExchangeRateConfig{
+			ECBSource:         env.Get("EXCHANGE_RATE_ECB_SOURCE", "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"),
+			PivotCurrencyCode: env.Get("EXCHANGE_RATE_PIVOT_CURRENCY", "EUR"),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return impl.NewEcbExchangeRateProvider(config)
+	})
+}
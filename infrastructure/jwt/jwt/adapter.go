@@ -38,16 +38,23 @@ func NewJwtAdapter(config *adapter.JwtConfig) *JwtAdapter {
 		panic(fmt.Errorf("invalid public key: %w", err))
 	}
 
-	return &JwtAdapter{config: config, privateKey: privateKey, publicKey: publicKey}
+	// the keys are always RSA, so tokens are always RS256
+	return &JwtAdapter{config: config, privateKey: privateKey, publicKey: publicKey, signingMethod: jwtlib.SigningMethodRS256}
 }
 func (a *JwtAdapter) Create(ctx context.Context, sessionKey string, info *adapter.OpenIDInfo, complete bool) (adapter.OpenIDToken, error) {
 	if err := ctx.Err(); err != nil {
 		return adapter.OpenIDToken{}, err
 	}
 
-	accessTTLms, err := a.parseTTLToMilliseconds(a.config.AccessTTL)
+	accessTTL := a.config.AccessTTL
+	if info.Actor != nil {
+		// impersonação: token curto e sem refresh
+		accessTTL = a.config.ImpersonationTTL
+		complete = false
+	}
+	accessTTLms, err := a.parseTTLToMilliseconds(accessTTL)
 	if err != nil {
-		return adapter.OpenIDToken{}, fmt.Errorf("invalid AccessTTL: %w", err)
+		return adapter.OpenIDToken{}, fmt.Errorf("invalid access ttl: %w", err)
 	}
 
 	now := time.Now().UTC()
@@ -138,6 +145,13 @@ func (a *JwtAdapter) Decode(ctx context.Context, tokenString string) (adapter.De
 		Theme:      a.toString(claims["theme"]),
 		Timezone:   a.toString(claims["timezone"]),
 	}
+	if act, ok := claims["act"].(map[string]any); ok {
+		actor := &adapter.Actor{Subject: a.toString(act["sub"])}
+		if actor.Subject == "" {
+			return adapter.DecodedToken{}, errors.New("missing act.sub in token")
+		}
+		openIDInfo.Actor = actor
+	}
 
 	return adapter.DecodedToken{
 		Kind:       kind,
@@ -146,7 +160,7 @@ func (a *JwtAdapter) Decode(ctx context.Context, tokenString string) (adapter.De
 	}, nil
 }
 func (a *JwtAdapter) buildBaseClaims(info *adapter.OpenIDInfo, sessionKey string) jwtlib.MapClaims {
-	return jwtlib.MapClaims{
+	claims := jwtlib.MapClaims{
 		// OpenIDInfo
 		"sub":         info.Subject,
 		"email":       info.Email,
@@ -161,6 +175,10 @@ func (a *JwtAdapter) buildBaseClaims(info *adapter.OpenIDInfo, sessionKey string
 		"iss": a.config.Issuer,
 		"jti": sessionKey,
 	}
+	if info.Actor != nil {
+		claims["act"] = map[string]any{"sub": info.Actor.Subject}
+	}
+	return claims
 }
 func (a *JwtAdapter) signWithType(claims jwtlib.MapClaims, typ string) (string, error) {
 	token := jwtlib.NewWithClaims(a.signingMethod, claims)
@@ -17,6 +17,7 @@ func init() {
 			SMTPIgnoreTLS: env.Get("MAILER_SMTP_IGNORE_TLS", false),
 			SMTPUsername:  env.Get("MAILER_SMTP_USERNAME", "{{MAILER_SMTP_USERNAME}}"),
 			SMTPPassword:  env.Get("MAILER_SMTP_PASSWORD", "{{MAILER_SMTP_PASSWORD}}"),
+			LinkBaseURL:   env.Get("MAILER_LINK_BASE_URL", "http://localhost:4000"),
 		}
 		if err := config.Validate(); err != nil {
 			panic(err)
@@ -37,6 +37,10 @@ func NewSMTPMailerAdapter(config *adapter.MailerConfig) *SMTPMailerAdapter {
 	return &SMTPMailerAdapter{config: config}
 }
 
+func (a *SMTPMailerAdapter) Config() *adapter.MailerConfig {
+	return a.config
+}
+
 func (a *SMTPMailerAdapter) Send(ctx context.Context, input adapter.MailPayload) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -0,0 +1,14 @@
+package metrics
+
+import (
+	adapter "src/application/adapter/metrics"
+	"src/core/di"
+	impl "src/infrastructure/metrics/prometheus"
+)
+
+func init() {
+	// singleton: every component records into the same registry
+	di.SingletonAs[adapter.IMetricsAdapter](func() adapter.IMetricsAdapter {
+		return impl.NewPrometheusMetricsAdapter()
+	})
+}
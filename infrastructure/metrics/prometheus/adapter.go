@@ -0,0 +1,59 @@
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	adapter "src/application/adapter/metrics"
+)
+
+type gauge struct {
+	help  string
+	value float64
+}
+
+// PrometheusMetricsAdapter keeps metrics in memory and renders them in the
+// Prometheus text exposition format (version 0.0.4) when scraped.
+type PrometheusMetricsAdapter struct {
+	mutex  sync.Mutex
+	gauges map[string]gauge
+}
+
+var _ adapter.IMetricsAdapter = (*PrometheusMetricsAdapter)(nil)
+
+func NewPrometheusMetricsAdapter() *PrometheusMetricsAdapter {
+	return &PrometheusMetricsAdapter{gauges: map[string]gauge{}}
+}
+
+func (a *PrometheusMetricsAdapter) SetGauge(name string, help string, value float64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.gauges[name] = gauge{help: help, value: value}
+}
+
+func (a *PrometheusMetricsAdapter) Write(w io.Writer) error {
+	a.mutex.Lock()
+	names := make([]string, 0, len(a.gauges))
+	for name := range a.gauges {
+		names = append(names, name)
+	}
+	gauges := make([]gauge, 0, len(names))
+	slices.Sort(names)
+	for _, name := range names {
+		gauges = append(gauges, a.gauges[name])
+	}
+	a.mutex.Unlock()
+
+	for index, name := range names {
+		help := strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(gauges[index].help)
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s %s\n",
+			name, help, name, name, strconv.FormatFloat(gauges[index].value, 'g', -1, 64)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,28 +9,21 @@ import (
 )
 
 type OpenidAdapter struct {
-	googleProvider    adapter.IOpenIDProvider
-	microsoftProvider adapter.IOpenIDProvider
+	providers map[string]adapter.IOpenIDProvider // by the name used in GetProvider
 }
 
 var _ adapter.IOpenIDAdapter = (*OpenidAdapter)(nil)
 
-func NewOpenidAdapter(config *adapter.OpenIDConfig) *OpenidAdapter {
-	return &OpenidAdapter{
-		googleProvider:    NewGoogleOpenIDProvider(config),
-		microsoftProvider: NewMicrosoftProvider(config),
-	}
+func NewOpenidAdapter(providers map[string]adapter.IOpenIDProvider) *OpenidAdapter {
+	return &OpenidAdapter{providers: providers}
 }
 
 func (a *OpenidAdapter) GetProvider(name string) (adapter.IOpenIDProvider, error) {
-	switch name {
-	case "google":
-		return a.googleProvider, nil
-	case "microsoft":
-		return a.microsoftProvider, nil
-	default:
+	provider, ok := a.providers[name]
+	if !ok {
 		return nil, fmt.Errorf("openid GetProvider: unsupported provider %q", name)
 	}
+	return provider, nil
 }
 
 func (a *OpenidAdapter) EncodeState(state map[string]string) (string, error) {
@@ -7,8 +7,18 @@ import (
 	http_impl "src/infrastructure/openid/http"
 )
 
+// keys of the IOpenIDProvider implementations, also the provider names
+// accepted by IOpenIDAdapter.GetProvider
+type googleProvider struct{}
+
+func (googleProvider) Key() string { return "google" }
+
+type microsoftProvider struct{}
+
+func (microsoftProvider) Key() string { return "microsoft" }
+
 func init() {
-	di.RegisterAs[adapter.IOpenIDAdapter](func() adapter.IOpenIDAdapter {
+	di.Singleton(func() *adapter.OpenIDConfig {
 		config := &adapter.OpenIDConfig{
 			BaseURI:               env.Get("OPENID_BASE_URI", "http://localhost:4000"),
 			MicrosoftClientID:     env.Get("OPENID_MICROSOFT_CLIENT_ID", "{{OPENID_MICROSOFT_CLIENT_ID}}"),
@@ -21,7 +31,23 @@ func init() {
 		if err := config.Validate(); err != nil {
 			panic(err)
 		}
+		return config
+	})
+
+	di.SingletonKeyed[adapter.IOpenIDProvider](googleProvider{}.Key(), func(config *adapter.OpenIDConfig) adapter.IOpenIDProvider {
+		return http_impl.NewGoogleOpenIDProvider(config)
+	})
+	di.SingletonKeyed[adapter.IOpenIDProvider](microsoftProvider{}.Key(), func(config *adapter.OpenIDConfig) adapter.IOpenIDProvider {
+		return http_impl.NewMicrosoftProvider(config)
+	})
 
-		return http_impl.NewOpenidAdapter(config)
+	di.RegisterAs[adapter.IOpenIDAdapter](func(
+		google di.Keyed[adapter.IOpenIDProvider, googleProvider],
+		microsoft di.Keyed[adapter.IOpenIDProvider, microsoftProvider],
+	) adapter.IOpenIDAdapter {
+		return http_impl.NewOpenidAdapter(map[string]adapter.IOpenIDProvider{
+			googleProvider{}.Key():    google.Value,
+			microsoftProvider{}.Key(): microsoft.Value,
+		})
 	})
 }
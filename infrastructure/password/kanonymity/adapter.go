@@ -0,0 +1,89 @@
+package kanonymity
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+
+	adapter "src/application/adapter/password"
+	"src/core/validator"
+)
+
+// rangeSource answers k-anonymity range queries: the SHA-1 suffixes known for
+// a 5 hex character prefix.
+type rangeSource interface {
+	Range(ctx context.Context, prefix string) (map[string]int, error)
+}
+
+type KAnonymityPasswordAdapter struct {
+	config *adapter.PasswordConfig
+	source rangeSource
+}
+
+var _ adapter.IPasswordAdapter = (*KAnonymityPasswordAdapter)(nil)
+
+// NewBundledPasswordAdapter screens against the list shipped with the binary.
+// It also stands in locally for a range service: Range answers the same
+// queries a remote one would.
+func NewBundledPasswordAdapter(config *adapter.PasswordConfig) *KAnonymityPasswordAdapter {
+	return &KAnonymityPasswordAdapter{config: config, source: newBundledSource()}
+}
+
+// NewRangePasswordAdapter screens through a remote range service that follows
+// the Pwned Passwords API ("SUFFIX:COUNT" lines).
+func NewRangePasswordAdapter(config *adapter.PasswordConfig) *KAnonymityPasswordAdapter {
+	return &KAnonymityPasswordAdapter{config: config, source: newRemoteSource(config)}
+}
+
+// NewUnscreenedPasswordAdapter applies the policy without any screening.
+func NewUnscreenedPasswordAdapter(config *adapter.PasswordConfig) *KAnonymityPasswordAdapter {
+	return &KAnonymityPasswordAdapter{config: config}
+}
+
+func (a *KAnonymityPasswordAdapter) Config() *adapter.PasswordConfig {
+	return a.config
+}
+
+func (a *KAnonymityPasswordAdapter) Policy(ctx context.Context, personal ...string) validator.PasswordPolicy {
+	policy := validator.PasswordPolicy{
+		MinLength:        a.config.MinLength,
+		MaxLength:        a.config.MaxLength,
+		RequireLowercase: a.config.RequireLowercase,
+		RequireUppercase: a.config.RequireUppercase,
+		RequireDigit:     a.config.RequireDigit,
+		RequireSymbol:    a.config.RequireSymbol,
+	}
+	if a.config.ForbidPersonal {
+		policy.Personal = personal
+	}
+	if a.source != nil {
+		policy.Breached = func(password string) bool {
+			breached, err := a.IsBreached(ctx, password)
+			return err == nil && breached
+		}
+	}
+	return policy
+}
+
+func (a *KAnonymityPasswordAdapter) IsBreached(ctx context.Context, password string) (bool, error) {
+	if a.source == nil || password == "" {
+		return false, nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes, err := a.source.Range(ctx, digest[:5])
+	if err != nil {
+		return false, err
+	}
+	return suffixes[digest[5:]] > 0, nil
+}
+
+func (a *KAnonymityPasswordAdapter) Range(ctx context.Context, prefix string) (map[string]int, error) {
+	if a.source == nil {
+		return map[string]int{}, nil
+	}
+	return a.source.Range(ctx, strings.ToUpper(prefix))
+}
@@ -0,0 +1,71 @@
+package kanonymity
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"strings"
+	"sync"
+)
+
+// common_passwords.txt.gz holds one common or leaked password per line.
+//
+//go:embed common_passwords.txt.gz
+var commonPasswords []byte
+
+// bundledSource indexes the embedded list by SHA-1 prefix the first time it
+// is queried.
+type bundledSource struct {
+	once     sync.Once
+	err      error
+	suffixes map[string]map[string]int
+}
+
+var _ rangeSource = (*bundledSource)(nil)
+
+func newBundledSource() *bundledSource {
+	return &bundledSource{}
+}
+
+func (s *bundledSource) Range(ctx context.Context, prefix string) (map[string]int, error) {
+	s.once.Do(s.load)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	// the caller may keep the result, hand out a copy
+	result := make(map[string]int, len(s.suffixes[prefix]))
+	for suffix, count := range s.suffixes[prefix] {
+		result[suffix] = count
+	}
+	return result, nil
+}
+
+func (s *bundledSource) load() {
+	reader, err := gzip.NewReader(bytes.NewReader(commonPasswords))
+	if err != nil {
+		s.err = err
+		return
+	}
+	defer reader.Close()
+
+	s.suffixes = map[string]map[string]int{}
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		password := strings.TrimRight(scanner.Text(), "\r")
+		if password == "" {
+			continue
+		}
+		sum := sha1.Sum([]byte(password))
+		digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+		if s.suffixes[digest[:5]] == nil {
+			s.suffixes[digest[:5]] = map[string]int{}
+		}
+		s.suffixes[digest[:5]][digest[5:]]++
+	}
+	s.err = scanner.Err()
+}
@@ -0,0 +1,67 @@
+package kanonymity
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	adapter "src/application/adapter/password"
+)
+
+// remoteSource queries a range service following the Pwned Passwords API:
+// GET <RangeURL><prefix> answers "SUFFIX:COUNT" lines.
+type remoteSource struct {
+	rangeURL string
+	client   *http.Client
+}
+
+var _ rangeSource = (*remoteSource)(nil)
+
+func newRemoteSource(config *adapter.PasswordConfig) *remoteSource {
+	return &remoteSource{
+		rangeURL: config.RangeURL,
+		client:   &http.Client{Timeout: time.Duration(config.Timeout) * time.Second},
+	}
+}
+
+func (s *remoteSource) Range(ctx context.Context, prefix string) (map[string]int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.rangeURL+prefix, nil)
+	if err != nil {
+		return nil, fmt.Errorf("password/kanonymity: %w", err)
+	}
+	// padding hides the real number of matches from an observer of the response size
+	request.Header.Set("Add-Padding", "true")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", adapter.ErrPassword_RangeUnavailable, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", adapter.ErrPassword_RangeUnavailable, response.StatusCode)
+	}
+
+	suffixes := map[string]int{}
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		suffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found {
+			continue
+		}
+		seen, err := strconv.Atoi(count)
+		if err != nil || seen <= 0 {
+			// padding entries have a zero count
+			continue
+		}
+		suffixes[strings.ToUpper(suffix)] = seen
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", adapter.ErrPassword_RangeUnavailable, err)
+	}
+	return suffixes, nil
+}
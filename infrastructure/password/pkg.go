@@ -0,0 +1,36 @@
+package password
+
+import (
+	adapter "src/application/adapter/password"
+	"src/core/di"
+	"src/core/env"
+	impl "src/infrastructure/password/kanonymity"
+)
+
+func init() {
+	di.RegisterAs[adapter.IPasswordAdapter](func() adapter.IPasswordAdapter {
+		config := &adapter.PasswordConfig{
+			MinLength:        env.Get("PASSWORD_MIN_LENGTH", 10),
+			MaxLength:        env.Get("PASSWORD_MAX_LENGTH", 128),
+			RequireLowercase: env.Get("PASSWORD_REQUIRE_LOWERCASE", false),
+			RequireUppercase: env.Get("PASSWORD_REQUIRE_UPPERCASE", false),
+			RequireDigit:     env.Get("PASSWORD_REQUIRE_DIGIT", false),
+			RequireSymbol:    env.Get("PASSWORD_REQUIRE_SYMBOL", false),
+			ForbidPersonal:   env.Get("PASSWORD_FORBID_PERSONAL", true),
+			Screening:        env.Get("PASSWORD_SCREENING", "bundled"),
+			RangeURL:         env.Get("PASSWORD_RANGE_URL", "https://api.pwnedpasswords.com/range/"),
+			Timeout:          env.Get("PASSWORD_RANGE_TIMEOUT", 3),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		switch config.Screening {
+		case "range":
+			return impl.NewRangePasswordAdapter(config)
+		case "none":
+			return impl.NewUnscreenedPasswordAdapter(config)
+		default:
+			return impl.NewBundledPasswordAdapter(config)
+		}
+	})
+}
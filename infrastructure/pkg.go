@@ -2,12 +2,20 @@ package infra
 
 import (
 	_ "src/infrastructure/cache"
+	_ "src/infrastructure/certificate"
 	_ "src/infrastructure/crypto"
 	_ "src/infrastructure/database"
+	_ "src/infrastructure/event"
+	_ "src/infrastructure/exchange_rate"
 	_ "src/infrastructure/jwt"
 	_ "src/infrastructure/logger"
 	_ "src/infrastructure/mailer"
+	_ "src/infrastructure/metrics"
 	_ "src/infrastructure/openid"
+	_ "src/infrastructure/password"
+	_ "src/infrastructure/realtime"
 	_ "src/infrastructure/storage"
 	_ "src/infrastructure/stream"
+	_ "src/infrastructure/totp"
+	_ "src/infrastructure/webauthn"
 )
@@ -0,0 +1,26 @@
+package realtime
+
+import (
+	adapter "src/application/adapter/realtime"
+	"src/application/adapter/stream"
+	"src/core/di"
+	"src/core/env"
+	impl "src/infrastructure/realtime/stream"
+)
+
+func init() {
+	// singleton: connections and replay history live in this instance
+	di.SingletonAs[adapter.IRealtimeAdapter](func(streamAdapter stream.IStreamAdapter) adapter.IRealtimeAdapter {
+		config := &adapter.RealtimeConfig{
+			Topic:                    env.Get("REALTIME_TOPIC", "control_plane.realtime"),
+			ReplayWindow:             env.Get("REALTIME_REPLAY_WINDOW", "10m"),
+			ReplaySize:               env.Get("REALTIME_REPLAY_SIZE", 100),
+			HeartbeatInterval:        env.Get("REALTIME_HEARTBEAT_INTERVAL", "15s"),
+			MaxConnectionsPerAccount: env.Get("REALTIME_MAX_CONNECTIONS_PER_ACCOUNT", 5),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return impl.NewStreamRealtimeAdapter(config, streamAdapter)
+	})
+}
@@ -0,0 +1,203 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	adapter "src/application/adapter/realtime"
+	stream "src/application/adapter/stream"
+)
+
+// StreamRealtimeAdapter fans realtime messages out through the stream
+// adapter, so a message published on one replica reaches the connections
+// held by every other replica. Recent messages are kept in memory for
+// Last-Event-ID resumes.
+type StreamRealtimeAdapter struct {
+	config       *adapter.RealtimeConfig
+	stream       stream.IStreamAdapter
+	replayWindow time.Duration
+
+	startMutex sync.Mutex
+	started    bool
+
+	mutex       sync.Mutex
+	subscribers map[uuid.UUID]map[*subscriber]struct{}
+	history     map[uuid.UUID][]adapter.Message
+	evictAt     time.Time
+}
+
+type subscriber struct {
+	channel chan adapter.Message
+	closed  bool
+}
+
+var _ adapter.IRealtimeAdapter = (*StreamRealtimeAdapter)(nil)
+
+func NewStreamRealtimeAdapter(config *adapter.RealtimeConfig, streamAdapter stream.IStreamAdapter) *StreamRealtimeAdapter {
+	if config == nil {
+		panic("realtime/stream: config is nil")
+	}
+
+	replayWindow, err := time.ParseDuration(config.ReplayWindow)
+	if err != nil {
+		panic(fmt.Errorf("realtime/stream: invalid ReplayWindow %q: %w", config.ReplayWindow, err))
+	}
+
+	return &StreamRealtimeAdapter{
+		config:       config,
+		stream:       streamAdapter,
+		replayWindow: replayWindow,
+		subscribers:  map[uuid.UUID]map[*subscriber]struct{}{},
+		history:      map[uuid.UUID][]adapter.Message{},
+	}
+}
+
+func (a *StreamRealtimeAdapter) Config() *adapter.RealtimeConfig {
+	return a.config
+}
+
+func (a *StreamRealtimeAdapter) Publish(ctx context.Context, message adapter.Message) error {
+	if message.ID == "" {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+		message.ID = id.String()
+	}
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = time.Now().UTC()
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	key := message.AccountID.String()
+	return a.stream.Publish(ctx, a.config.Topic, stream.Payload{Message: data, Key: &key})
+}
+
+func (a *StreamRealtimeAdapter) Subscribe(ctx context.Context, accountID uuid.UUID, lastEventID string) (<-chan adapter.Message, error) {
+	if err := a.start(); err != nil {
+		return nil, err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if len(a.subscribers[accountID]) >= a.config.MaxConnectionsPerAccount {
+		return nil, adapter.ErrRealtime_ConnectionLimit
+	}
+
+	current := &subscriber{channel: make(chan adapter.Message, a.config.ReplaySize+32)}
+	if lastEventID != "" {
+		// v7 ids sort by time, so everything after the last seen id is new
+		for _, message := range a.history[accountID] {
+			if message.ID > lastEventID && a.inReplayWindow(message) {
+				current.channel <- message
+			}
+		}
+	}
+
+	if a.subscribers[accountID] == nil {
+		a.subscribers[accountID] = map[*subscriber]struct{}{}
+	}
+	a.subscribers[accountID][current] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		a.mutex.Lock()
+		defer a.mutex.Unlock()
+		a.unsubscribe(accountID, current)
+	}()
+
+	return current.channel, nil
+}
+
+// start subscribes to the shared topic once, on the first local connection.
+// A failed subscription is tried again by the next connection.
+func (a *StreamRealtimeAdapter) start() error {
+	a.startMutex.Lock()
+	defer a.startMutex.Unlock()
+	if a.started {
+		return nil
+	}
+	// every replica needs every message, so the subscription has no group
+	if err := a.stream.Subscribe(context.Background(), a.config.Topic, "", a.dispatch); err != nil {
+		return err
+	}
+	a.started = true
+	return nil
+}
+
+func (a *StreamRealtimeAdapter) dispatch(payload stream.Payload) error {
+	var message adapter.Message
+	if err := json.Unmarshal(payload.Message, &message); err != nil {
+		return err
+	}
+	if !a.inReplayWindow(message) {
+		return nil
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.config.ReplaySize > 0 {
+		history := append(a.history[message.AccountID], message)
+		for len(history) > 0 && (len(history) > a.config.ReplaySize || !a.inReplayWindow(history[0])) {
+			history = history[1:]
+		}
+		a.history[message.AccountID] = history
+		a.evictIdle()
+	}
+
+	for current := range a.subscribers[message.AccountID] {
+		select {
+		case current.channel <- message:
+		default:
+			// the client fell behind; closing makes it reconnect and resume
+			a.unsubscribe(message.AccountID, current)
+		}
+	}
+
+	return nil
+}
+
+func (a *StreamRealtimeAdapter) unsubscribe(accountID uuid.UUID, current *subscriber) {
+	if current.closed {
+		return
+	}
+	current.closed = true
+	close(current.channel)
+
+	delete(a.subscribers[accountID], current)
+	if len(a.subscribers[accountID]) == 0 {
+		delete(a.subscribers, accountID)
+	}
+}
+
+// evictIdle drops, once per replay window, the history of accounts whose
+// newest message can no longer be replayed. Trimming on dispatch only
+// reaches accounts that keep receiving messages.
+func (a *StreamRealtimeAdapter) evictIdle() {
+	now := time.Now()
+	if now.Before(a.evictAt) {
+		return
+	}
+	a.evictAt = now.Add(a.replayWindow)
+
+	for accountID, history := range a.history {
+		if len(history) == 0 || !a.inReplayWindow(history[len(history)-1]) {
+			delete(a.history, accountID)
+		}
+	}
+}
+
+func (a *StreamRealtimeAdapter) inReplayWindow(message adapter.Message) bool {
+	return time.Since(message.CreatedAt) <= a.replayWindow
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	kafkago "github.com/segmentio/kafka-go"
@@ -13,9 +14,19 @@ import (
 	adapter "src/application/adapter/stream"
 )
 
+// Handler failures are retried with a delay doubling between these bounds.
+const (
+	minRetryDelay = 100 * time.Millisecond
+	maxRetryDelay = 30 * time.Second
+)
+
 type KafkaStreamAdapter struct {
 	brokers []string
 	writer  *kafkago.Writer
+
+	mutex         sync.Mutex
+	cancels       []context.CancelFunc
+	subscriptions sync.WaitGroup
 }
 
 var _ adapter.IStreamAdapter = (*KafkaStreamAdapter)(nil)
@@ -89,56 +100,140 @@ func (a *KafkaStreamAdapter) Publish(ctx context.Context, topic string, payload
 	return a.writer.WriteMessages(ctx, message)
 }
 
-func (a *KafkaStreamAdapter) Subscribe(ctx context.Context, topic string, handler func(payload adapter.Payload) error) error {
+// Subscribe reads the topic until ctx is done. Subscriptions sharing a group
+// split its partitions and resume from the group's committed offsets; a
+// message is committed once its handler succeeded and retried until then.
+// An empty group reads every partition directly from its newest message,
+// without a consumer group, so every subscriber sees everything published
+// from then on, each message handled once whatever the outcome. Partitions
+// added to the topic later are not picked up by such a subscription.
+func (a *KafkaStreamAdapter) Subscribe(ctx context.Context, topic string, group string, handler func(payload adapter.Payload) error) error {
 	if topic == "" {
 		return fmt.Errorf("stream/kafka: topic is required")
 	}
 
-	reader := kafkago.NewReader(kafkago.ReaderConfig{
-		Brokers:     a.brokers,
-		Topic:       topic,
-		StartOffset: kafkago.FirstOffset,
-		MinBytes:    1,
-		MaxBytes:    10e6,
-	})
+	if group != "" {
+		a.consume(ctx, kafkago.NewReader(kafkago.ReaderConfig{
+			Brokers:     a.brokers,
+			Topic:       topic,
+			GroupID:     group,
+			StartOffset: kafkago.FirstOffset,
+			MinBytes:    1,
+			MaxBytes:    10e6,
+		}), func(ctx context.Context, reader *kafkago.Reader, msg kafkago.Message) bool {
+			// committed only once handled, so a message that was not is
+			// fetched again by the group
+			return deliver(ctx, handler, toPayload(msg)) && reader.CommitMessages(ctx, msg) == nil
+		})
+		return nil
+	}
+
+	partitions, err := kafkago.LookupPartitions(ctx, "tcp", a.brokers[0], topic)
+	if err != nil {
+		return fmt.Errorf("stream/kafka: looking up partitions of %q: %w", topic, err)
+	}
+	if len(partitions) == 0 {
+		return fmt.Errorf("stream/kafka: topic %q has no partitions", topic)
+	}
+	for _, partition := range partitions {
+		a.consume(ctx, kafkago.NewReader(kafkago.ReaderConfig{
+			Brokers:     a.brokers,
+			Topic:       topic,
+			Partition:   partition.ID,
+			StartOffset: kafkago.LastOffset,
+			MinBytes:    1,
+			MaxBytes:    10e6,
+		}), func(_ context.Context, _ *kafkago.Reader, msg kafkago.Message) bool {
+			// a broadcast is best effort, nobody else would pick it up
+			_ = handler(toPayload(msg))
+			return true
+		})
+	}
+
+	return nil
+}
+
+// consume fetches messages from reader and passes them to handle until ctx is
+// done, Close is called or handle returns false.
+func (a *KafkaStreamAdapter) consume(ctx context.Context, reader *kafkago.Reader, handle func(ctx context.Context, reader *kafkago.Reader, msg kafkago.Message) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	a.mutex.Lock()
+	a.cancels = append(a.cancels, cancel)
+	a.subscriptions.Add(1)
+	a.mutex.Unlock()
 
 	go func() {
+		defer a.subscriptions.Done()
 		defer reader.Close()
 
 		for {
-			msg, err := reader.ReadMessage(ctx)
-			if err != nil {
+			msg, err := reader.FetchMessage(ctx)
+			if err != nil || !handle(ctx, reader, msg) {
 				return
 			}
+		}
+	}()
+}
 
-			payload := adapter.Payload{
-				Message: msg.Value,
-			}
+// deliver runs handler until it succeeds, waiting longer after every failure.
+// A payload with MaxRetries is given up after that many retries. It returns
+// false when ctx is done first, leaving the message uncommitted.
+func deliver(ctx context.Context, handler func(payload adapter.Payload) error, payload adapter.Payload) bool {
+	delay := minRetryDelay
+	for retries := 0; ; retries++ {
+		if handler(payload) == nil || (payload.MaxRetries != nil && retries >= *payload.MaxRetries) {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
 
-			if len(msg.Key) > 0 {
-				key := string(msg.Key)
-				payload.Key = &key
-			}
+func toPayload(msg kafkago.Message) adapter.Payload {
+	payload := adapter.Payload{
+		Message: msg.Value,
+	}
 
-			for _, header := range msg.Headers {
-				switch header.Key {
-				case "ttl_ms":
-					if millis, err := strconv.ParseInt(string(header.Value), 10, 64); err == nil {
-						duration := time.Duration(millis) * time.Millisecond
-						payload.TTL = &duration
-					}
-				case "max_retries":
-					if retries, err := strconv.Atoi(string(header.Value)); err == nil {
-						payload.MaxRetries = &retries
-					}
-				}
-			}
+	if len(msg.Key) > 0 {
+		key := string(msg.Key)
+		payload.Key = &key
+	}
 
-			_ = handler(payload)
+	for _, header := range msg.Headers {
+		switch header.Key {
+		case "ttl_ms":
+			if millis, err := strconv.ParseInt(string(header.Value), 10, 64); err == nil {
+				duration := time.Duration(millis) * time.Millisecond
+				payload.TTL = &duration
+			}
+		case "max_retries":
+			if retries, err := strconv.Atoi(string(header.Value)); err == nil {
+				payload.MaxRetries = &retries
+			}
 		}
-	}()
+	}
 
-	return nil
+	return payload
+}
+
+// Close stops the subscriptions, waits for the messages being handled and
+// flushes the writer. A message still being retried is left uncommitted.
+func (a *KafkaStreamAdapter) Close() error {
+	a.mutex.Lock()
+	cancels := a.cancels
+	a.cancels = nil
+	a.mutex.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+	a.subscriptions.Wait()
+
+	return a.writer.Close()
 }
 
 func parseKafkaBrokers(rawURI string) ([]string, error) {
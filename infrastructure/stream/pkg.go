@@ -10,7 +10,8 @@ import (
 )
 
 func init() {
-	di.RegisterAs[adapter.IStreamAdapter](func() adapter.IStreamAdapter {
+	// singleton: the connections are shared, and closed with the container
+	di.SingletonAs[adapter.IStreamAdapter](func() adapter.IStreamAdapter {
 		config := &adapter.StreamConfig{
 			KafkaURI: env.Get("STREAM_KAFKA_URI", "localhost:9092"),
 		}
@@ -0,0 +1,23 @@
+package totp
+
+import (
+	adapter "src/application/adapter/totp"
+	"src/core/di"
+	"src/core/env"
+	impl "src/infrastructure/totp/rfc6238"
+)
+
+func init() {
+	di.RegisterAs[adapter.ITotpAdapter](func() adapter.ITotpAdapter {
+		config := &adapter.TotpConfig{
+			Issuer: env.Get("TOTP_ISSUER", "Control Plane"),
+			Digits: env.Get("TOTP_DIGITS", 6),
+			Period: env.Get("TOTP_PERIOD", 30),
+			Skew:   env.Get("TOTP_SKEW", 1),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return impl.NewRfc6238TotpAdapter(config)
+	})
+}
@@ -0,0 +1,93 @@
+package rfc6238
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	adapter "src/application/adapter/totp"
+)
+
+// secretSize follows the RFC 4226 recommendation of 160 bits for HMAC-SHA1.
+const secretSize = 20
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type Rfc6238TotpAdapter struct {
+	config *adapter.TotpConfig
+}
+
+var _ adapter.ITotpAdapter = (*Rfc6238TotpAdapter)(nil)
+
+func NewRfc6238TotpAdapter(config *adapter.TotpConfig) *Rfc6238TotpAdapter {
+	if config == nil {
+		panic("totp/rfc6238: config is nil")
+	}
+	return &Rfc6238TotpAdapter{config: config}
+}
+
+func (a *Rfc6238TotpAdapter) Config() *adapter.TotpConfig {
+	return a.config
+}
+
+func (a *Rfc6238TotpAdapter) GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+func (a *Rfc6238TotpAdapter) URI(secret string, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", a.config.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(a.config.Digits))
+	query.Set("period", fmt.Sprint(a.config.Period))
+
+	label := url.PathEscape(a.config.Issuer) + ":" + url.PathEscape(accountName)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+func (a *Rfc6238TotpAdapter) Validate(secret string, code string, at time.Time) (int64, bool) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil || len(code) != a.config.Digits {
+		return 0, false
+	}
+
+	current := at.Unix() / int64(a.config.Period)
+	for offset := -a.config.Skew; offset <= a.config.Skew; offset++ {
+		step := current + int64(offset)
+		if subtle.ConstantTimeCompare([]byte(a.generate(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// generate computes the RFC 4226 HOTP value for a counter.
+func (a *Rfc6238TotpAdapter) generate(key []byte, counter int64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range a.config.Digits {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", a.config.Digits, value%modulo)
+}
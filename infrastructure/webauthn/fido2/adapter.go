@@ -0,0 +1,259 @@
+package fido2
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+
+	adapter "src/application/adapter/webauthn"
+)
+
+// Authenticator data flags.
+const (
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagAttestedData   = 0x40
+	flagExtensionData  = 0x80
+)
+
+type Fido2WebAuthnAdapter struct {
+	config          *adapter.WebAuthnConfig
+	origins         map[string]bool
+	relyingPartyKey [32]byte
+}
+
+var _ adapter.IWebAuthnAdapter = (*Fido2WebAuthnAdapter)(nil)
+
+func NewFido2WebAuthnAdapter(config *adapter.WebAuthnConfig) *Fido2WebAuthnAdapter {
+	origins := map[string]bool{}
+	for origin := range strings.SplitSeq(config.Origins, ",") {
+		if origin = strings.TrimRight(strings.TrimSpace(origin), "/"); origin != "" {
+			origins[origin] = true
+		}
+	}
+	return &Fido2WebAuthnAdapter{
+		config:          config,
+		origins:         origins,
+		relyingPartyKey: sha256.Sum256([]byte(config.RelyingPartyID)),
+	}
+}
+
+func (a *Fido2WebAuthnAdapter) Config() *adapter.WebAuthnConfig {
+	return a.config
+}
+
+func (a *Fido2WebAuthnAdapter) NewChallenge() (string, error) {
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
+
+func (a *Fido2WebAuthnAdapter) CreationOptions(challenge string, user adapter.User, excludeCredentialIDs [][]byte) *adapter.CreationOptions {
+	return &adapter.CreationOptions{
+		Challenge:    challenge,
+		RelyingParty: adapter.RelyingParty{ID: a.config.RelyingPartyID, Name: a.config.RelyingPartyName},
+		User:         user,
+		PubKeyCredParams: []adapter.CredentialParameter{
+			{Type: "public-key", Alg: coseAlgES256},
+			{Type: "public-key", Alg: coseAlgEdDSA},
+			{Type: "public-key", Alg: coseAlgRS256},
+		},
+		Timeout:            a.config.Timeout * 1000,
+		ExcludeCredentials: descriptors(excludeCredentialIDs),
+		AuthenticatorSelection: adapter.AuthenticatorSelection{
+			ResidentKey:      "required",
+			UserVerification: a.config.UserVerification,
+		},
+		Attestation: "none",
+	}
+}
+
+func (a *Fido2WebAuthnAdapter) RequestOptions(challenge string, allowCredentialIDs [][]byte) *adapter.RequestOptions {
+	return &adapter.RequestOptions{
+		Challenge:        challenge,
+		RelyingPartyID:   a.config.RelyingPartyID,
+		Timeout:          a.config.Timeout * 1000,
+		AllowCredentials: descriptors(allowCredentialIDs),
+		UserVerification: a.config.UserVerification,
+	}
+}
+
+func (a *Fido2WebAuthnAdapter) VerifyRegistration(challenge string, response *adapter.AttestationResponse) (*adapter.Credential, error) {
+	if err := a.verifyClientData(response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	decoded, _, err := decodeCbor(response.AttestationObject)
+	if err != nil {
+		return nil, adapter.ErrWebAuthn_InvalidAttestation
+	}
+	object, _ := decoded.(map[any]any)
+	format, _ := object["fmt"].(string)
+	statement, _ := object["attStmt"].(map[any]any)
+	rawAuthenticatorData, _ := object["authData"].([]byte)
+	if format == "" || statement == nil || rawAuthenticatorData == nil {
+		return nil, adapter.ErrWebAuthn_InvalidAttestation
+	}
+
+	data, err := a.parseAuthenticatorData(rawAuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	if data.flags&flagAttestedData == 0 || data.credentialKey == nil {
+		return nil, adapter.ErrWebAuthn_InvalidAuthenticator
+	}
+
+	clientDataHash := sha256.Sum256(response.ClientDataJSON)
+	switch format {
+	case "none":
+		if len(statement) != 0 {
+			return nil, adapter.ErrWebAuthn_InvalidAttestation
+		}
+	case "packed":
+		if err := verifyPacked(statement, data, append(append([]byte(nil), rawAuthenticatorData...), clientDataHash[:]...)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, adapter.ErrWebAuthn_UnsupportedAttestation
+	}
+
+	return &adapter.Credential{
+		ID:                data.credentialID,
+		PublicKey:         data.rawCredentialKey,
+		SignCount:         data.signCount,
+		Aaguid:            data.aaguid.String(),
+		AttestationFormat: format,
+		UserVerified:      data.flags&flagUserVerified != 0,
+		BackupEligible:    data.flags&flagBackupEligible != 0,
+	}, nil
+}
+
+func (a *Fido2WebAuthnAdapter) VerifyAssertion(challenge string, publicKey []byte, signCount uint32, response *adapter.AssertionResponse) (*adapter.Assertion, error) {
+	if err := a.verifyClientData(response.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return nil, err
+	}
+	data, err := a.parseAuthenticatorData(response.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, err := parseCoseKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(response.ClientDataJSON)
+	message := append(append([]byte(nil), response.AuthenticatorData...), clientDataHash[:]...)
+	if !key.verify(message, response.Signature) {
+		return nil, adapter.ErrWebAuthn_InvalidSignature
+	}
+
+	// authenticators without a counter always report zero
+	if (data.signCount != 0 || signCount != 0) && data.signCount <= signCount {
+		return nil, adapter.ErrWebAuthn_SignCountRegression
+	}
+
+	return &adapter.Assertion{
+		SignCount:    data.signCount,
+		UserVerified: data.flags&flagUserVerified != 0,
+	}, nil
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+func (a *Fido2WebAuthnAdapter) verifyClientData(raw []byte, ceremony string, challenge string) error {
+	var data clientData
+	if err := json.Unmarshal(raw, &data); err != nil || data.Type != ceremony || data.CrossOrigin {
+		return adapter.ErrWebAuthn_InvalidClientData
+	}
+	if challenge == "" || subtle.ConstantTimeCompare([]byte(data.Challenge), []byte(challenge)) != 1 {
+		return adapter.ErrWebAuthn_ChallengeMismatch
+	}
+	if !a.origins[data.Origin] {
+		return adapter.ErrWebAuthn_OriginMismatch
+	}
+	return nil
+}
+
+type authenticatorData struct {
+	flags            byte
+	signCount        uint32
+	aaguid           uuid.UUID
+	credentialID     []byte
+	rawCredentialKey []byte
+	credentialKey    *coseKey
+}
+
+// parseAuthenticatorData reads rpIdHash (32), flags (1), signCount (4) and,
+// when flagged, the attested credential data. Extensions are skipped.
+func (a *Fido2WebAuthnAdapter) parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < 37 {
+		return nil, adapter.ErrWebAuthn_InvalidAuthenticator
+	}
+	if !bytes.Equal(raw[:32], a.relyingPartyKey[:]) {
+		return nil, adapter.ErrWebAuthn_RelyingPartyMismatch
+	}
+	data := &authenticatorData{flags: raw[32], signCount: binary.BigEndian.Uint32(raw[33:37])}
+	if data.flags&flagUserPresent == 0 {
+		return nil, adapter.ErrWebAuthn_UserNotPresent
+	}
+	if a.config.UserVerification == "required" && data.flags&flagUserVerified == 0 {
+		return nil, adapter.ErrWebAuthn_UserNotVerified
+	}
+
+	rest := raw[37:]
+	if data.flags&flagAttestedData != 0 {
+		if len(rest) < 18 {
+			return nil, adapter.ErrWebAuthn_InvalidAuthenticator
+		}
+		copy(data.aaguid[:], rest[:16])
+		length := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if length == 0 || length > 1023 || len(rest) < length {
+			return nil, adapter.ErrWebAuthn_InvalidAuthenticator
+		}
+		data.credentialID = append([]byte(nil), rest[:length]...)
+		rest = rest[length:]
+
+		key, afterKey, err := parseCoseKey(rest)
+		if err != nil {
+			return nil, err
+		}
+		data.credentialKey = key
+		data.rawCredentialKey = append([]byte(nil), rest[:len(rest)-len(afterKey)]...)
+		rest = afterKey
+	}
+	if data.flags&flagExtensionData != 0 {
+		_, afterExtensions, err := decodeCbor(rest)
+		if err != nil {
+			return nil, adapter.ErrWebAuthn_InvalidAuthenticator
+		}
+		rest = afterExtensions
+	}
+	if len(rest) != 0 {
+		return nil, adapter.ErrWebAuthn_InvalidAuthenticator
+	}
+	return data, nil
+}
+
+func descriptors(credentialIDs [][]byte) []adapter.CredentialDescriptor {
+	result := make([]adapter.CredentialDescriptor, 0, len(credentialIDs))
+	for _, id := range credentialIDs {
+		result = append(result, adapter.CredentialDescriptor{Type: "public-key", ID: base64.RawURLEncoding.EncodeToString(id)})
+	}
+	return result
+}
@@ -0,0 +1,223 @@
+package fido2
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	adapter "src/application/adapter/webauthn"
+)
+
+// The testdata responses were recorded for relying party "localhost" and
+// origin "http://localhost:4000". The assertions are signed by the
+// credential of none_attestation.json.
+
+type attestationFixture struct {
+	Challenge         string `json:"challenge"`
+	ClientDataJSON    string `json:"client_data_json"`
+	AttestationObject string `json:"attestation_object"`
+	CredentialID      string `json:"credential_id"`
+	Aaguid            string `json:"aaguid"`
+}
+
+type assertionFixture struct {
+	Challenge         string `json:"challenge"`
+	PublicKey         string `json:"public_key"`
+	SignCount         uint32 `json:"sign_count"`
+	ClientDataJSON    string `json:"client_data_json"`
+	AuthenticatorData string `json:"authenticator_data"`
+	Signature         string `json:"signature"`
+}
+
+func loadFixture[T any](t *testing.T, name string) T {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixture T
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		t.Fatal(err)
+	}
+	return fixture
+}
+
+func decodeFixture(t *testing.T, value string) []byte {
+	t.Helper()
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func newTestAdapter(relyingPartyID string) *Fido2WebAuthnAdapter {
+	return NewFido2WebAuthnAdapter(&adapter.WebAuthnConfig{
+		RelyingPartyID:   relyingPartyID,
+		RelyingPartyName: "Control Plane",
+		Origins:          "http://localhost:4000",
+		Timeout:          300,
+		UserVerification: "preferred",
+	})
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	tests := []struct {
+		name           string
+		fixture        string
+		relyingPartyID string
+		challenge      func(fixture attestationFixture) string
+		mutate         func(response *adapter.AttestationResponse)
+		err            error
+	}{
+		{name: "none", fixture: "none_attestation.json"},
+		{name: "packed", fixture: "packed_attestation.json"},
+		{
+			name:      "challenge mismatch",
+			fixture:   "none_attestation.json",
+			challenge: func(attestationFixture) string { return "another-challenge" },
+			err:       adapter.ErrWebAuthn_ChallengeMismatch,
+		},
+		{
+			name:           "rpIdHash mismatch",
+			fixture:        "none_attestation.json",
+			relyingPartyID: "example.com",
+			err:            adapter.ErrWebAuthn_RelyingPartyMismatch,
+		},
+		{
+			name:    "packed signature failure",
+			fixture: "packed_attestation.json",
+			mutate: func(response *adapter.AttestationResponse) {
+				// the client data is hashed into the signed message
+				response.ClientDataJSON = append(response.ClientDataJSON, ' ')
+			},
+			err: adapter.ErrWebAuthn_InvalidAttestation,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := loadFixture[attestationFixture](t, test.fixture)
+			relyingPartyID := "localhost"
+			if test.relyingPartyID != "" {
+				relyingPartyID = test.relyingPartyID
+			}
+			challenge := fixture.Challenge
+			if test.challenge != nil {
+				challenge = test.challenge(fixture)
+			}
+			response := &adapter.AttestationResponse{
+				ClientDataJSON:    decodeFixture(t, fixture.ClientDataJSON),
+				AttestationObject: decodeFixture(t, fixture.AttestationObject),
+			}
+			if test.mutate != nil {
+				test.mutate(response)
+			}
+
+			credential, err := newTestAdapter(relyingPartyID).VerifyRegistration(challenge, response)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("got error %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := base64.RawURLEncoding.EncodeToString(credential.ID); got != fixture.CredentialID {
+				t.Errorf("credential id %s, want %s", got, fixture.CredentialID)
+			}
+			if want := uuid.UUID(decodeFixture(t, fixture.Aaguid)).String(); credential.Aaguid != want {
+				t.Errorf("aaguid %s, want %s", credential.Aaguid, want)
+			}
+			if !credential.UserVerified {
+				t.Error("user verification flag was lost")
+			}
+		})
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	tests := []struct {
+		name           string
+		fixture        string
+		relyingPartyID string
+		signCount      uint32
+		mutate         func(response *adapter.AssertionResponse)
+		err            error
+	}{
+		{name: "valid", fixture: "assertion.json", signCount: 4},
+		{name: "first use", fixture: "assertion.json", signCount: 0},
+		{
+			name:      "signature failure",
+			fixture:   "assertion.json",
+			signCount: 4,
+			mutate: func(response *adapter.AssertionResponse) {
+				response.Signature[len(response.Signature)-1] ^= 0xff
+			},
+			err: adapter.ErrWebAuthn_InvalidSignature,
+		},
+		{
+			name:           "rpIdHash mismatch",
+			fixture:        "assertion.json",
+			relyingPartyID: "example.com",
+			signCount:      4,
+			err:            adapter.ErrWebAuthn_RelyingPartyMismatch,
+		},
+		{
+			name:      "missing UP flag",
+			fixture:   "assertion_user_not_present.json",
+			signCount: 4,
+			err:       adapter.ErrWebAuthn_UserNotPresent,
+		},
+		{
+			name:      "sign count replayed",
+			fixture:   "assertion.json",
+			signCount: 5,
+			err:       adapter.ErrWebAuthn_SignCountRegression,
+		},
+		{
+			name:      "sign count regression",
+			fixture:   "assertion.json",
+			signCount: 9,
+			err:       adapter.ErrWebAuthn_SignCountRegression,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := loadFixture[assertionFixture](t, test.fixture)
+			relyingPartyID := "localhost"
+			if test.relyingPartyID != "" {
+				relyingPartyID = test.relyingPartyID
+			}
+			response := &adapter.AssertionResponse{
+				ClientDataJSON:    decodeFixture(t, fixture.ClientDataJSON),
+				AuthenticatorData: decodeFixture(t, fixture.AuthenticatorData),
+				Signature:         decodeFixture(t, fixture.Signature),
+			}
+			if test.mutate != nil {
+				test.mutate(response)
+			}
+
+			assertion, err := newTestAdapter(relyingPartyID).VerifyAssertion(fixture.Challenge, decodeFixture(t, fixture.PublicKey), test.signCount, response)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("got error %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if assertion.SignCount != fixture.SignCount {
+				t.Errorf("sign count %d, want %d", assertion.SignCount, fixture.SignCount)
+			}
+		})
+	}
+}
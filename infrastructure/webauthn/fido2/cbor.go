@@ -0,0 +1,118 @@
+package fido2
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var errCbor = errors.New("cbor: malformed or unsupported item")
+
+// maxCborDepth bounds nesting so a hostile payload cannot exhaust the stack.
+const maxCborDepth = 16
+
+// decodeCbor reads one CBOR (RFC 8949) data item and returns it with the
+// remaining bytes. Only the definite-length subset used by CTAP2 is accepted:
+// integers become int64, byte strings []byte, text strings string, arrays
+// []any, maps map[any]any and simple values bool or nil.
+func decodeCbor(data []byte) (any, []byte, error) {
+	return decodeCborItem(data, 0)
+}
+
+func decodeCborItem(data []byte, depth int) (any, []byte, error) {
+	if depth > maxCborDepth || len(data) == 0 {
+		return nil, nil, errCbor
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	argument, rest, err := cborArgument(info, data[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0:
+		if argument > math.MaxInt64 {
+			return nil, nil, errCbor
+		}
+		return int64(argument), rest, nil
+	case 1:
+		if argument > math.MaxInt64 {
+			return nil, nil, errCbor
+		}
+		return -1 - int64(argument), rest, nil
+	case 2, 3:
+		if argument > uint64(len(rest)) {
+			return nil, nil, errCbor
+		}
+		value := rest[:argument]
+		if major == 3 {
+			return string(value), rest[argument:], nil
+		}
+		return append([]byte(nil), value...), rest[argument:], nil
+	case 4:
+		if argument > uint64(len(rest)) {
+			return nil, nil, errCbor
+		}
+		items := make([]any, 0, argument)
+		for range argument {
+			var item any
+			if item, rest, err = decodeCborItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, rest, nil
+	case 5:
+		if argument > uint64(len(rest)) {
+			return nil, nil, errCbor
+		}
+		items := make(map[any]any, argument)
+		for range argument {
+			var key, value any
+			if key, rest, err = decodeCborItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errCbor
+			}
+			if value, rest, err = decodeCborItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			if _, duplicate := items[key]; duplicate {
+				return nil, nil, errCbor
+			}
+			items[key] = value
+		}
+		return items, rest, nil
+	case 7:
+		switch info {
+		case 20:
+			return false, rest, nil
+		case 21:
+			return true, rest, nil
+		case 22:
+			return nil, rest, nil
+		}
+	}
+	return nil, nil, errCbor
+}
+
+// cborArgument decodes the length or value that follows the initial byte.
+// Indefinite lengths (31) and floats are refused.
+func cborArgument(info byte, data []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), data, nil
+	case info == 24 && len(data) >= 1:
+		return uint64(data[0]), data[1:], nil
+	case info == 25 && len(data) >= 2:
+		return uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case info == 26 && len(data) >= 4:
+		return uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case info == 27 && len(data) >= 8:
+		return binary.BigEndian.Uint64(data), data[8:], nil
+	}
+	return 0, nil, errCbor
+}
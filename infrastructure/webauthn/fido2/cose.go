@@ -0,0 +1,98 @@
+package fido2
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+
+	adapter "src/application/adapter/webauthn"
+)
+
+// COSE algorithm identifiers (RFC 9053) offered to authenticators, in order
+// of preference.
+const (
+	coseAlgES256 = -7
+	coseAlgEdDSA = -8
+	coseAlgRS256 = -257
+)
+
+// COSE key type and parameter labels.
+const (
+	coseKeyType      = 1
+	coseKeyAlgorithm = 3
+	coseKtyOKP       = 1
+	coseKtyEC2       = 2
+	coseKtyRSA       = 3
+	coseCrvP256      = 1
+	coseCrvEd25519   = 6
+)
+
+// coseKey is a decoded credential public key bound to its algorithm.
+type coseKey struct {
+	Algorithm int64
+	PublicKey crypto.PublicKey
+}
+
+func parseCoseKey(data []byte) (*coseKey, []byte, error) {
+	decoded, rest, err := decodeCbor(data)
+	if err != nil {
+		return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+	}
+	key, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+	}
+	kty, _ := key[int64(coseKeyType)].(int64)
+	alg, _ := key[int64(coseKeyAlgorithm)].(int64)
+
+	switch {
+	case kty == coseKtyEC2 && alg == coseAlgES256:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if crv != coseCrvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+		}
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+		}
+		return &coseKey{Algorithm: alg, PublicKey: publicKey}, rest, nil
+	case kty == coseKtyRSA && alg == coseAlgRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+		}
+		exponent := new(big.Int).SetBytes(e)
+		return &coseKey{Algorithm: alg, PublicKey: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}}, rest, nil
+	case kty == coseKtyOKP && alg == coseAlgEdDSA:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		if crv != coseCrvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+		}
+		return &coseKey{Algorithm: alg, PublicKey: ed25519.PublicKey(x)}, rest, nil
+	}
+	return nil, nil, adapter.ErrWebAuthn_UnsupportedKey
+}
+
+// verify checks a WebAuthn signature over message. ECDSA signatures are
+// ASN.1 DER encoded, unlike in JOSE.
+func (k *coseKey) verify(message []byte, signature []byte) bool {
+	switch publicKey := k.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return ecdsa.VerifyASN1(publicKey, digest[:], signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(publicKey, message, signature)
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package fido2
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"slices"
+
+	adapter "src/application/adapter/webauthn"
+)
+
+// id-fido-gen-ce-aaguid, the attestation certificate extension naming the
+// authenticator model.
+var oidFidoAaguid = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 45724, 1, 1, 4}
+
+// verifyPacked checks a "packed" attestation statement (WebAuthn §8.2).
+// With x5c the signature is made by the attestation certificate, otherwise
+// it is a self attestation made by the credential key itself.
+func verifyPacked(statement map[any]any, data *authenticatorData, signed []byte) error {
+	alg, _ := statement["alg"].(int64)
+	signature, _ := statement["sig"].([]byte)
+	if signature == nil {
+		return adapter.ErrWebAuthn_InvalidAttestation
+	}
+	if _, ecdaa := statement["ecdaaKeyId"]; ecdaa {
+		return adapter.ErrWebAuthn_UnsupportedAttestation
+	}
+
+	chain, hasChain := statement["x5c"].([]any)
+	if !hasChain {
+		if alg != data.credentialKey.Algorithm || !data.credentialKey.verify(signed, signature) {
+			return adapter.ErrWebAuthn_InvalidAttestation
+		}
+		return nil
+	}
+
+	if len(chain) == 0 {
+		return adapter.ErrWebAuthn_InvalidAttestation
+	}
+	raw, _ := chain[0].([]byte)
+	certificate, err := x509.ParseCertificate(raw)
+	if err != nil {
+		return errors.Join(adapter.ErrWebAuthn_InvalidAttestation, err)
+	}
+	if err := checkAttestationCertificate(certificate, data); err != nil {
+		return err
+	}
+
+	var algorithm x509.SignatureAlgorithm
+	switch alg {
+	case coseAlgES256:
+		algorithm = x509.ECDSAWithSHA256
+	case coseAlgRS256:
+		algorithm = x509.SHA256WithRSA
+	case coseAlgEdDSA:
+		algorithm = x509.PureEd25519
+	default:
+		return adapter.ErrWebAuthn_UnsupportedAttestation
+	}
+	if err := certificate.CheckSignature(algorithm, signed, signature); err != nil {
+		return errors.Join(adapter.ErrWebAuthn_InvalidAttestation, err)
+	}
+	return nil
+}
+
+// checkAttestationCertificate applies the packed certificate requirements
+// (WebAuthn §8.2.1).
+func checkAttestationCertificate(certificate *x509.Certificate, data *authenticatorData) error {
+	if certificate.Version != 3 || certificate.IsCA ||
+		!slices.Contains(certificate.Subject.OrganizationalUnit, "Authenticator Attestation") ||
+		len(certificate.Subject.Organization) == 0 || len(certificate.Subject.Country) == 0 || certificate.Subject.CommonName == "" {
+		return adapter.ErrWebAuthn_InvalidAttestation
+	}
+	for _, extension := range certificate.Extensions {
+		if !extension.Id.Equal(oidFidoAaguid) {
+			continue
+		}
+		var aaguid []byte
+		if _, err := asn1.Unmarshal(extension.Value, &aaguid); err != nil || extension.Critical || !bytes.Equal(aaguid, data.aaguid[:]) {
+			return adapter.ErrWebAuthn_InvalidAttestation
+		}
+	}
+	return nil
+}
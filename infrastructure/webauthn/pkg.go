@@ -0,0 +1,24 @@
+package webauthn
+
+import (
+	adapter "src/application/adapter/webauthn"
+	"src/core/di"
+	"src/core/env"
+	impl "src/infrastructure/webauthn/fido2"
+)
+
+func init() {
+	di.RegisterAs[adapter.IWebAuthnAdapter](func() adapter.IWebAuthnAdapter {
+		config := &adapter.WebAuthnConfig{
+			RelyingPartyID:   env.Get("WEBAUTHN_RELYING_PARTY_ID", "localhost"),
+			RelyingPartyName: env.Get("WEBAUTHN_RELYING_PARTY_NAME", "Control Plane"),
+			Origins:          env.Get("WEBAUTHN_ORIGINS", "http://localhost:4000"),
+			Timeout:          env.Get("WEBAUTHN_TIMEOUT", 300),
+			UserVerification: env.Get("WEBAUTHN_USER_VERIFICATION", "preferred"),
+		}
+		if err := config.Validate(); err != nil {
+			panic(err)
+		}
+		return impl.NewFido2WebAuthnAdapter(config)
+	})
+}
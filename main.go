@@ -2,30 +2,72 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
 	"strconv"
+	"strings"
+	"time"
 
-	_ "src/application"
-	_ "src/domain"
 	_ "src/infrastructure"
 
+	"src/application"
 	"src/application/adapter/logger"
-	"src/application/usecase/system/query"
+	"src/application/adapter/outbox"
+	"src/application/lifecycle"
+	"src/application/usecase/currency/command/seed_currency"
+	"src/application/usecase/system/query/healthcheck"
 	"src/core/cqrs"
 	"src/core/di"
 	"src/core/env"
+	"src/domain"
 	"src/presentation/api"
 )
 
 func main() {
 	env.Load("./.env", "../.env")
 
-	cqrs.MustExecuteQuery[query.HealthcheckQueryResult](context.Background(), &query.HealthcheckQuery{})
+	domain.Register()
+	application.Register()
+
+	if err := di.Validate(); err != nil {
+		panic(err)
+	}
+	// DI_GRAPH=dot|json prints the dependency graph instead of starting
+	switch env.Get("DI_GRAPH", "") {
+	case "dot":
+		fmt.Print(di.DependencyGraph().DOT())
+		return
+	case "json":
+		if err := json.NewEncoder(os.Stdout).Encode(di.DependencyGraph()); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	cqrs.MustExecuteQuery[healthcheck.Result](context.Background(), &healthcheck.Query{})
+
+	if env.Get("CURRENCY_SEED_ON_STARTUP", "true") == "true" {
+		cqrs.MustExecuteCommand[seed_currency.Result](context.Background(), &seed_currency.Command{
+			ActiveCodes: strings.Split(env.Get("CURRENCY_SEED_ACTIVE_CODES", "BRL,EUR,USD"), ","),
+		})
+	}
 
 	logger := di.Resolve[logger.ILoggerAdapter]()
 	server := di.Resolve[*api.Server]()
 
+	// the server comes last, so requests only arrive once the rest runs
+	host := lifecycle.NewHost(&lifecycle.HostConfig{
+		ShutdownTimeout: env.Get("APP_SHUTDOWN_TIMEOUT", 30*time.Second),
+		CloseTimeout:    env.Get("APP_CLOSE_TIMEOUT", 10*time.Second),
+	}, logger).
+		Add("event consumer", lifecycle.WorkerFunc(cqrs.ConsumeEvents)).
+		Add("outbox relay", di.Resolve[outbox.IOutboxRelay]()).
+		Add("http server", lifecycle.WorkerFunc(server.ListenAndServe))
+
 	logger.Info("Server started on :" + strconv.Itoa(server.Config.Port))
-	if err := server.ListenAndServe(context.Background()); err != nil {
-		panic(err)
+	if err := host.Run(context.Background()); err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"net/http"
+
+	"src/application/usecase/document/query/verify_signed_document"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/core/meta"
+	"src/domain/exception"
+	"src/presentation/api/rest/core"
+	"src/presentation/api/rest/guard"
+	"src/presentation/api/rest/interceptor"
+	"src/presentation/api/rest/oas"
+)
+
+type DocumentController struct {
+	tags string
+}
+
+var _ core.IRestController = (*DocumentController)(nil)
+
+func NewDocumentController() *DocumentController {
+	return &DocumentController{tags: "Document"}
+}
+
+func (c *DocumentController) Router() core.Router {
+	return core.NewRouter().PrefixPath("/document").
+		Push(c.PostVerifySigned())
+}
+
+func (c *DocumentController) PostVerifySigned() *core.RouteBuilder {
+	metadata := meta.GetObjectMetadataAs[verify_signed_document.Query]()
+	return core.NewRoute().Post("/signed/:id/verify").
+		OperationId("DocumentVerifySigned").Tags(c.tags).
+		Summary("Verify a signed document").Description(metadata.Description).
+		PathParameter(func(p *oas.BuildParameter) {
+			p.Name("id").Description("Signed document id").Required(true)
+		}).
+		RequestBody(func(b *oas.BuildRequestBody) {
+			b.Description("Optionally, a copy of the document to verify instead of the stored file").
+				Content(oas.ContentType_ApplicationJson, func(m *oas.BuildMediaType) {
+					m.Schema(oas.ObjectMetadata(metadata)).Example(metadata.Example)
+				})
+		}).
+		Response(http.StatusOK, func(r *oas.BuildResponse) {
+			metadata := meta.GetObjectMetadataAs[verify_signed_document.Result]()
+			r.Description(metadata.Description).Content(oas.ContentType_ApplicationJson, func(m *oas.BuildMediaType) {
+				m.Schema(oas.ObjectMetadata(metadata)).Example(metadata.Example)
+			})
+		}).
+		ResponseThrowsFromMetadata(metadata).
+		ResponseUnprocessableEntityException().
+		ResponseValidationException().
+		Handler(func(ctx core.HttpContext) error {
+			query := &verify_signed_document.Query{}
+			if err := ctx.Body(query); err != nil {
+				return exception.NewUnprocessableEntity().WithCause(err)
+			}
+			query.SignedDocumentID = ctx.Param("id")
+
+			result, err := cqrs.ExecuteQuery[verify_signed_document.Result](ctx.Context(), query)
+			if err != nil {
+				return err
+			}
+			return ctx.JSON(http.StatusOK, result)
+		}).
+		UseGuards(guard.AuthGuard("document:read")).
+		UseInterceptors(interceptor.LoggingInterceptor())
+}
+
+func init() {
+	di.RegisterAs[core.IRestController](NewDocumentController)
+}
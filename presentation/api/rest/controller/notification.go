@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"src/application/adapter/realtime"
+	"src/core/common"
+	"src/core/di"
+	"src/domain/exception"
+	"src/presentation/api/rest/core"
+	"src/presentation/api/rest/guard"
+	"src/presentation/api/rest/interceptor"
+	"src/presentation/api/rest/oas"
+)
+
+const (
+	Err_StreamConnectionLimit = "too many open notification streams for this account"
+
+	// streamRetry tells EventSource clients how long to wait before reconnecting.
+	streamRetry = 3 * time.Second
+)
+
+type NotificationController struct {
+	tags string
+}
+
+var _ core.IRestController = (*NotificationController)(nil)
+
+func NewNotificationController() *NotificationController {
+	return &NotificationController{tags: "Notification"}
+}
+
+func (c *NotificationController) Router() core.Router {
+	return core.NewRouter().PrefixPath("/notification").
+		Push(c.GetStream())
+}
+
+func (c *NotificationController) GetStream() *core.RouteBuilder {
+	return core.NewRoute().Get("/stream").
+		OperationId("NotificationStream").Tags(c.tags).
+		Summary("Stream notifications").
+		Description("Server-Sent Events stream of the caller's new notifications (event \"notification\") and session revocations (event \"session_revoked\"). Send Last-Event-ID to resume after a reconnect.").
+		HeaderParameter(func(p *oas.BuildParameter) {
+			p.Name("Last-Event-ID").Description("Id of the last event received, to resume the stream")
+		}).
+		Response(http.StatusOK, func(r *oas.BuildResponse) {
+			r.Description("Event stream").Content(oas.ContentType_TextEventStream, func(m *oas.BuildMediaType) {
+				m.Example("id: 01926b4e-5f3a-7c2e-9a41-8d2f6e0b7c13\nevent: notification\ndata: {\"id\":\"...\"}\n\n")
+			})
+		}).
+		ResponseUnauthorizedException().
+		ResponseConflictException().
+		Handler(func(ctx core.HttpContext) error {
+			info := common.GetRequestInfo(ctx.Context())
+			realtimeAdapter := di.Resolve[realtime.IRealtimeAdapter]()
+			heartbeatInterval, err := time.ParseDuration(realtimeAdapter.Config().HeartbeatInterval)
+			if err != nil {
+				return exception.NewInternal().WithCause(err)
+			}
+
+			// the stream outlives the handler, so it cannot use the request
+			// context; it ends with the server instead
+			streamCtx, cancel := context.WithCancel(core.GetServerContext(ctx.Context()))
+			messages, err := realtimeAdapter.Subscribe(streamCtx, *info.AccountID, ctx.Header("Last-Event-ID"))
+			if err != nil {
+				cancel()
+				if errors.Is(err, realtime.ErrRealtime_ConnectionLimit) {
+					return exception.NewConflict().WithMessage(Err_StreamConnectionLimit)
+				}
+				return exception.NewInternal().WithCause(err)
+			}
+
+			ctx.HeaderSet("Content-Type", string(oas.ContentType_TextEventStream))
+			ctx.HeaderSet("Cache-Control", "no-cache")
+			ctx.HeaderSet("Connection", "keep-alive")
+			ctx.HeaderSet("X-Accel-Buffering", "no")
+			ctx.Status(http.StatusOK)
+
+			sessionKey := info.SessionKey
+			ctx.Stream(func(w *bufio.Writer) {
+				defer cancel()
+				writeStream(w, messages, sessionKey, heartbeatInterval)
+			})
+			return nil
+		}).
+		UseGuards(guard.AuthGuard("notification:read")).
+		UseInterceptors(interceptor.LoggingInterceptor())
+}
+
+// writeStream forwards messages as SSE events until the client disconnects,
+// the subscription closes (also when the server shuts down) or the current
+// session is revoked.
+func writeStream(w *bufio.Writer, messages <-chan realtime.Message, sessionKey string, heartbeatInterval time.Duration) {
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds())
+	if err := w.Flush(); err != nil {
+		return
+	}
+
+	for {
+		closeStream := false
+
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", message.ID, message.Event, message.Data)
+			closeStream = message.Event == realtime.Event_SessionRevoked && revokes(message, sessionKey)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+
+		if err := w.Flush(); err != nil || closeStream {
+			return
+		}
+	}
+}
+
+func revokes(message realtime.Message, sessionKey string) bool {
+	var revoked struct {
+		SessionID string `json:"session_id"`
+	}
+	if err := json.Unmarshal(message.Data, &revoked); err != nil {
+		return false
+	}
+	return revoked.SessionID == sessionKey
+}
+
+func init() {
+	di.RegisterAs[core.IRestController](NewNotificationController)
+}
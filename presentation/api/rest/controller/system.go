@@ -1,9 +1,14 @@
 package controller
 
 import (
+	"bufio"
+	"fmt"
 	"net/http"
+	"slices"
 
-	"src/application/usecase/system/query"
+	"src/application/adapter/metrics"
+	"src/application/usecase/system/query/get_password_range"
+	"src/application/usecase/system/query/healthcheck"
 	"src/core/cqrs"
 	"src/core/di"
 	"src/core/meta"
@@ -24,27 +29,95 @@ func NewSystemController() *SystemController {
 
 func (c *SystemController) Router() core.Router {
 	return core.NewRouter().
-		Push(c.GetHealth())
+		Push(c.GetHealth()).
+		Push(c.GetPasswordRange()).
+		Push(c.GetMetrics())
 }
 
 func (c *SystemController) GetHealth() *core.RouteBuilder {
-	metadata := meta.GetObjectMetadataAs[query.HealthcheckQuery]()
+	metadata := meta.GetObjectMetadataAs[healthcheck.Query]()
 	return core.NewRoute().Get("/health").
 		OperationId("SystemHealth").Tags(c.tags).
 		Summary(metadata.Description).Description(metadata.Description).
 		Response(http.StatusOK, func(r *oas.BuildResponse) {
-			metadata := meta.GetObjectMetadataAs[query.HealthcheckQueryResult]()
+			metadata := meta.GetObjectMetadataAs[healthcheck.Result]()
 			r.Description(metadata.Description).Content(oas.ContentType_ApplicationJson, func(m *oas.BuildMediaType) {
 				m.Schema(oas.ObjectMetadata(metadata)).Example(metadata.Example)
 			})
 		}).
 		Handler(func(ctx core.HttpContext) error {
-			result := cqrs.MustExecuteQuery[query.HealthcheckQueryResult](ctx.Context(), &query.HealthcheckQuery{})
+			result := cqrs.MustExecuteQuery[healthcheck.Result](ctx.Context(), &healthcheck.Query{})
 			return ctx.JSON(http.StatusOK, result)
 		}).
 		UseInterceptors(interceptor.LoggingInterceptor())
 }
 
+// GetPasswordRange answers like the Pwned Passwords range API ("SUFFIX:COUNT"
+// lines), so clients and other instances can screen passwords against this
+// server as a local stand-in.
+func (c *SystemController) GetPasswordRange() *core.RouteBuilder {
+	metadata := meta.GetObjectMetadataAs[get_password_range.Query]()
+	return core.NewRoute().Get("/password/range/:prefix").
+		OperationId("SystemPasswordRange").Tags(c.tags).
+		Summary("Leaked password range lookup").Description(metadata.Description).
+		PathParameter(func(p *oas.BuildParameter) {
+			p.Name("prefix").Description("First 5 hex characters of the password SHA-1").Required(true)
+		}).
+		Response(http.StatusOK, func(r *oas.BuildResponse) {
+			r.Description("One SUFFIX:COUNT line per known password").Content(oas.ContentType_TextPlain, func(m *oas.BuildMediaType) {
+				m.Example("1E4C9B93F3F0682250B6CF8331B7EE68FD8:1\r\n")
+			})
+		}).
+		ResponseThrowsFromMetadata(metadata).
+		ResponseValidationException().
+		Handler(func(ctx core.HttpContext) error {
+			query := &get_password_range.Query{Prefix: ctx.Param("prefix")}
+			result, err := cqrs.ExecuteQuery[get_password_range.Result](ctx.Context(), query)
+			if err != nil {
+				return err
+			}
+
+			suffixes := make([]string, 0, len(result.Suffixes))
+			for suffix := range result.Suffixes {
+				suffixes = append(suffixes, suffix)
+			}
+			slices.Sort(suffixes)
+
+			ctx.HeaderSet("Content-Type", string(oas.ContentType_TextPlain))
+			ctx.Status(http.StatusOK)
+			ctx.Stream(func(w *bufio.Writer) {
+				for _, suffix := range suffixes {
+					fmt.Fprintf(w, "%s:%d\r\n", suffix, result.Suffixes[suffix])
+				}
+				w.Flush()
+			})
+			return nil
+		}).
+		UseInterceptors(interceptor.LoggingInterceptor())
+}
+
+// GetMetrics serves the application metrics for a Prometheus scraper.
+func (c *SystemController) GetMetrics() *core.RouteBuilder {
+	metricsAdapter := di.Resolve[metrics.IMetricsAdapter]()
+	return core.NewRoute().Get("/metrics").
+		OperationId("SystemMetrics").Tags(c.tags).
+		Summary("Application metrics").Description("Gauges in the Prometheus text exposition format").
+		Response(http.StatusOK, func(r *oas.BuildResponse) {
+			r.Description("One sample per metric").Content(oas.ContentType_TextPlain, func(m *oas.BuildMediaType) {
+				m.Example("# HELP outbox_pending_messages Outbox messages not published yet.\n# TYPE outbox_pending_messages gauge\noutbox_pending_messages 0\n")
+			})
+		}).
+		Handler(func(ctx core.HttpContext) error {
+			ctx.HeaderSet("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+			ctx.Status(http.StatusOK)
+			ctx.Stream(func(w *bufio.Writer) {
+				_ = metricsAdapter.Write(w)
+				w.Flush()
+			})
+			return nil
+		})
+}
+
 func init() {
 	di.RegisterAs[core.IRestController](NewSystemController)
 }
@@ -1,12 +1,16 @@
 package core
 
 import (
+	"bufio"
 	"context"
 	"net/url"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// Header_TenantID selects the tenant a request acts on.
+const Header_TenantID = "X-Tenant-ID"
+
 type HttpContext interface {
 	Context() context.Context
 
@@ -20,6 +24,27 @@ type HttpContext interface {
 	Status(code int)
 	JSON(status int, body any) error
 	HeaderSet(key, value string)
+	// Stream sends the response body through writer after the handler
+	// returns; the request itself is no longer accessible at that point.
+	Stream(writer func(w *bufio.Writer))
+}
+
+type serverContextKey struct{}
+
+// WithServerContext stores the context of the serving server, cancelled as
+// soon as the server starts shutting down.
+func WithServerContext(ctx context.Context, serverCtx context.Context) context.Context {
+	return context.WithValue(ctx, serverContextKey{}, serverCtx)
+}
+
+// GetServerContext returns the server context stored in ctx, for work that
+// outlives the request, such as an event stream, but must end with the
+// server. Outside a server it never ends.
+func GetServerContext(ctx context.Context) context.Context {
+	if serverCtx, ok := ctx.Value(serverContextKey{}).(context.Context); ok && serverCtx != nil {
+		return serverCtx
+	}
+	return context.Background()
 }
 
 type fiberHttpContext struct {
@@ -77,3 +102,7 @@ func (c *fiberHttpContext) JSON(status int, body any) error {
 func (c *fiberHttpContext) HeaderSet(key, value string) {
 	c.ctx.Set(key, value)
 }
+
+func (c *fiberHttpContext) Stream(writer func(w *bufio.Writer)) {
+	c.ctx.Context().SetBodyStreamWriter(writer)
+}
@@ -1,14 +1,20 @@
 package core
 
 import (
+	"errors"
 	"net/http"
 	"reflect"
 
+	"github.com/gofiber/fiber/v2"
+
+	"src/core"
 	"src/core/validator"
 	"src/domain/exception"
+	"src/domain/exception/too_many_requests"
 )
 
 var HTTPStatusMap = map[reflect.Type]int{
+	reflect.TypeFor[*validator.Error]():               http.StatusBadRequest,          // 400
 	reflect.TypeFor[*validator.ValidationError]():     http.StatusBadRequest,          // 400
 	reflect.TypeFor[*exception.Validation]():          http.StatusBadRequest,          // 400
 	reflect.TypeFor[*exception.Unauthorized]():        http.StatusUnauthorized,        // 401
@@ -19,9 +25,26 @@ var HTTPStatusMap = map[reflect.Type]int{
 	reflect.TypeFor[*exception.PreconditionFailed]():  http.StatusPreconditionFailed,  // 412
 	reflect.TypeFor[*exception.MethodNotAllowed]():    http.StatusMethodNotAllowed,    // 405
 	reflect.TypeFor[*exception.NotAcceptable]():       http.StatusNotAcceptable,       // 406
+	reflect.TypeFor[*exception.TooManyRequests]():     http.StatusTooManyRequests,     // 429
 	reflect.TypeFor[*exception.Internal]():            http.StatusInternalServerError, // 500
 }
 
+// HTTPStatusCodeMap resolves exceptions returned as their core.Error value
+// (WithMessage, WithCause, ...), which no longer carry the exception type.
+var HTTPStatusCodeMap = map[string]int{
+	exception.NewValidation().Code:          http.StatusBadRequest,          // 400
+	exception.NewUnauthorized().Code:        http.StatusUnauthorized,        // 401
+	exception.NewForbidden().Code:           http.StatusForbidden,           // 403
+	exception.NewNotFound().Code:            http.StatusNotFound,            // 404
+	exception.NewConflict().Code:            http.StatusConflict,            // 409
+	exception.NewUnprocessableEntity().Code: http.StatusUnprocessableEntity, // 422
+	exception.NewPreconditionFailed().Code:  http.StatusPreconditionFailed,  // 412
+	exception.NewMethodNotAllowed().Code:    http.StatusMethodNotAllowed,    // 405
+	exception.NewNotAcceptable().Code:       http.StatusNotAcceptable,       // 406
+	exception.NewTooManyRequests().Code:     http.StatusTooManyRequests,     // 429
+	exception.NewInternal().Code:            http.StatusInternalServerError, // 500
+}
+
 func GetHTTPStatus(err any) int {
 	if err == nil {
 		return http.StatusOK
@@ -42,5 +65,41 @@ func GetHTTPStatus(err any) int {
 		}
 	}
 
+	if asError, ok := err.(error); ok {
+		var coreError core.Error
+		if errors.As(asError, &coreError) {
+			if status, ok := HTTPStatusCodeMap[coreError.Code]; ok {
+				return status
+			}
+		}
+	}
+
 	return http.StatusInternalServerError
 }
+
+// GetHTTPHeaders returns the response headers an error asks for, such as the
+// Retry-After of a TooManyRequests exception.
+func GetHTTPHeaders(err error) map[string]string {
+	headers := map[string]string{}
+
+	var retryAfter too_many_requests.RetryAfter
+	if errors.As(err, &retryAfter) {
+		headers[fiber.HeaderRetryAfter] = retryAfter.Seconds()
+	}
+
+	return headers
+}
+
+// GetHTTPErrorBody builds the JSON body of an error response. Validation
+// failures also list every field error with its code, so clients can
+// translate them.
+func GetHTTPErrorBody(err error) map[string]any {
+	body := map[string]any{"error": err.Error()}
+
+	var validationError *validator.Error
+	if errors.As(err, &validationError) && validationError.HasErrors() {
+		body["errors"] = validationError.Errors
+	}
+
+	return body
+}
@@ -375,6 +375,9 @@ func (b *RouteBuilder) ResponseMethodNotAllowedException() *RouteBuilder {
 func (b *RouteBuilder) ResponseNotAcceptableException() *RouteBuilder {
 	return b.responseException(http.StatusNotAcceptable, exception.NotAcceptable{})
 }
+func (b *RouteBuilder) ResponseTooManyRequestsException() *RouteBuilder {
+	return b.responseException(http.StatusTooManyRequests, exception.TooManyRequests{})
+}
 
 func (b *RouteBuilder) ResponseInternalException() *RouteBuilder {
 	return b.responseException(http.StatusInternalServerError, exception.Internal{})
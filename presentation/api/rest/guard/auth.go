@@ -0,0 +1,150 @@
+package guard
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"src/application/adapter/jwt"
+	"src/application/usecase/access_token/query/authenticate_access_token"
+	"src/application/usecase/identity/query/authenticate_session"
+	"src/application/usecase/tenant/query/authorize_tenant_member"
+	"src/core/common"
+	"src/core/cqrs"
+	"src/core/di"
+	"src/domain/exception"
+	"src/presentation/api/rest/core"
+)
+
+const tokenKind_Access = "access"
+
+// Scope_MfaEnrollment marks the routes a session may still use while a
+// tenant of its account requires MFA and the account has not enrolled.
+const Scope_MfaEnrollment = "mfa:enroll"
+
+const Err_MfaEnrollmentRequired = "a tenant of the account requires MFA, enroll before continuing"
+
+// AuthGuard requires a valid access token and records the caller in the
+// request info. EventSource clients cannot set headers, so event streams may
+// pass the token in the "access_token" query parameter instead.
+//
+// Besides session JWTs, refused once their session is revoked, it accepts
+// personal access tokens and tenant API keys. A JWT with an "act" claim is an
+// impersonation token: the actor is recorded as the impersonator.
+// A token limited to scopes only passes routes that list one of them in
+// optionalScopes; sessions and unrestricted tokens pass every route, except
+// that a session that has to enroll MFA is limited to Scope_MfaEnrollment.
+//
+// The tenant named by the tenant header is only recorded once the caller is
+// known to be one of its members.
+func AuthGuard(optionalScopes ...string) core.GuardFN {
+	jwtAdapter := di.Resolve[jwt.IJwtAdapter]()
+	return func(ctx core.HttpContext) error {
+		token := bearerToken(ctx)
+		if token == "" {
+			return exception.NewUnauthorized().Error
+		}
+		if !isJWT(token) {
+			return authenticateAccessToken(ctx, token, optionalScopes)
+		}
+
+		decoded, err := jwtAdapter.Decode(ctx.Context(), token)
+		if err != nil || decoded.Kind != tokenKind_Access {
+			return exception.NewUnauthorized().Error
+		}
+
+		accountID, err := uuid.Parse(decoded.OpenIDInfo.Subject)
+		if err != nil {
+			return exception.NewUnauthorized().Error
+		}
+
+		session, err := cqrs.ExecuteQuery[authenticate_session.Result](ctx.Context(), &authenticate_session.Query{
+			SessionKey: decoded.SessionKey,
+			AccountID:  accountID.String(),
+		})
+		if err != nil {
+			if core.GetHTTPStatus(err) >= http.StatusInternalServerError {
+				return err
+			}
+			return exception.NewUnauthorized().Error
+		}
+		if session.MfaEnrollmentRequired && !slices.Contains(optionalScopes, Scope_MfaEnrollment) {
+			return exception.NewForbidden().WithMessage(Err_MfaEnrollmentRequired)
+		}
+
+		info := common.GetRequestInfo(ctx.Context())
+		info.AccountID = &accountID
+		info.SessionKey = decoded.SessionKey
+		if decoded.OpenIDInfo.Actor != nil {
+			impersonatorID, err := uuid.Parse(decoded.OpenIDInfo.Actor.Subject)
+			if err != nil {
+				return exception.NewUnauthorized().Error
+			}
+			info.ImpersonatorAccountID = &impersonatorID
+		}
+		return selectTenant(ctx, info)
+	}
+}
+
+func authenticateAccessToken(ctx core.HttpContext, token string, scopes []string) error {
+	result, err := cqrs.ExecuteQuery[authenticate_access_token.Result](ctx.Context(), &authenticate_access_token.Query{Token: token})
+	if err != nil {
+		// a malformed token is as unknown as a wrong one
+		if core.GetHTTPStatus(err) >= http.StatusInternalServerError {
+			return err
+		}
+		return exception.NewUnauthorized().Error
+	}
+	if len(result.Scopes) > 0 && !slices.ContainsFunc(scopes, func(scope string) bool {
+		return slices.Contains(result.Scopes, scope)
+	}) {
+		return exception.NewForbidden().Error
+	}
+
+	info := common.GetRequestInfo(ctx.Context())
+	info.AccountID = &result.AccountID
+	info.AccessTokenID = &result.AccessTokenID
+	info.Scopes = result.Scopes
+	// an API key only ever acts within its own tenant
+	if result.TenantID != nil {
+		info.TenantID = result.TenantID
+		return nil
+	}
+	return selectTenant(ctx, info)
+}
+
+// selectTenant records the tenant named by the tenant header when the caller
+// is an active member of it, and none otherwise.
+func selectTenant(ctx core.HttpContext, info *common.RequestInfo) error {
+	tenantID, err := uuid.Parse(ctx.Header(core.Header_TenantID))
+	if err != nil {
+		return nil
+	}
+	result, err := cqrs.ExecuteQuery[authorize_tenant_member.Result](ctx.Context(), &authorize_tenant_member.Query{TenantID: tenantID.String()})
+	if err != nil {
+		return err
+	}
+	if result.Member {
+		info.TenantID = &tenantID
+	}
+	return nil
+}
+
+// isJWT tells a compact JWS (header.payload.signature) from an access token,
+// which never contains a dot.
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+func bearerToken(ctx core.HttpContext) string {
+	scheme, token, found := strings.Cut(ctx.Header("Authorization"), " ")
+	if found && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	if strings.Contains(ctx.Header("Accept"), "text/event-stream") {
+		return ctx.Query("access_token")
+	}
+	return ""
+}
@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"src/application/adapter/logger"
+	"src/core/common"
 	"src/core/di"
 	"src/presentation/api/rest/core"
 	"time"
@@ -16,6 +17,11 @@ func LoggingInterceptor() core.InterceptorFN {
 		if err != nil {
 			optionalMap["err"] = err.Error()
 		}
+		if info := common.GetRequestInfo(ctx.Context()); info.ImpersonatorAccountID != nil {
+			optionalMap["impersonated"] = true
+			optionalMap["account_id"] = info.AccountID.String()
+			optionalMap["impersonator_account_id"] = info.ImpersonatorAccountID.String()
+		}
 		logger.Info("Request completed", optionalMap)
 		return err
 	}
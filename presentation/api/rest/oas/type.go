@@ -25,6 +25,7 @@ const (
 	ContentType_TextHtml        ContentTypeEnum = "text/html"
 	ContentType_TextXml         ContentTypeEnum = "text/xml"
 	ContentType_TextCsv         ContentTypeEnum = "text/csv"
+	ContentType_TextEventStream ContentTypeEnum = "text/event-stream"
 	ContentType_ImageJpeg       ContentTypeEnum = "image/jpeg"
 	ContentType_ImagePng        ContentTypeEnum = "image/png"
 	ContentType_ImageGif        ContentTypeEnum = "image/gif"
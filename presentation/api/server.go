@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"path"
 	"strconv"
-	"time"
 
 	"github.com/gofiber/fiber/v2"
 
 	"src/application/adapter/logger"
+	"src/core/common"
 	"src/core/di"
 	"src/core/env"
 	"src/presentation/api/rest/core"
@@ -23,6 +23,11 @@ type Server struct {
 	Config *core.Config
 	app    *fiber.App
 	routes []core.Route
+
+	// serving is cancelled when shutdown starts, ending the responses that
+	// stream past their handler
+	serving     context.Context
+	stopServing context.CancelFunc
 }
 
 func NewServer(config *core.Config) *Server {
@@ -31,6 +36,7 @@ func NewServer(config *core.Config) *Server {
 	}
 
 	server := &Server{Config: config}
+	server.serving, server.stopServing = context.WithCancel(context.Background())
 
 	server.app = fiber.New(fiber.Config{
 		DisableStartupMessage: true,
@@ -43,8 +49,11 @@ func NewServer(config *core.Config) *Server {
 			logger := di.Resolve[logger.ILoggerAdapter]()
 			logger.Error(err.Error(), map[string]any{"method": c.Method(), "path": c.Path()})
 			status := core.GetHTTPStatus(err)
+			for key, value := range core.GetHTTPHeaders(err) {
+				c.Set(key, value)
+			}
 
-			return ctx.JSON(status, map[string]any{"error": err.Error()})
+			return ctx.JSON(status, core.GetHTTPErrorBody(err))
 		},
 	})
 
@@ -110,6 +119,14 @@ func (s *Server) loadControllers() {
 func (s *Server) registerRoute(route core.Route) {
 	handler := func(c *fiber.Ctx) error {
 		ctx := core.NewFiberHttpContext(c)
+		requestCtx := core.WithServerContext(common.WithRequestInfo(ctx.Context(), s.requestInfo(c)), s.serving)
+		scopeCtx, scope := di.WithScope(requestCtx)
+		c.SetUserContext(scopeCtx)
+		defer func() {
+			if err := scope.Close(); err != nil {
+				di.Resolve[logger.ILoggerAdapter]().Error(err.Error(), map[string]any{"method": c.Method(), "path": c.Path()})
+			}
+		}()
 
 		for _, guard := range route.Guards {
 			if err := guard(ctx); err != nil {
@@ -145,6 +162,15 @@ func (s *Server) registerRoute(route core.Route) {
 	}
 }
 
+// requestInfo captures the caller metadata of a request; guards complete it
+// with the authenticated account and the tenant it acts on.
+func (s *Server) requestInfo(c *fiber.Ctx) *common.RequestInfo {
+	return &common.RequestInfo{
+		IP:        c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}
+}
+
 func (s *Server) enableSwaggerUIHandler() {
 	swaggerUIPath := path.Clean(s.Config.BasePath + s.Config.SwaggerPath)
 	swaggerJSONPath := path.Clean(swaggerUIPath + "/openapi.json")
@@ -196,6 +222,8 @@ func (s *Server) Routes() []core.Route {
 	return s.routes
 }
 
+// ListenAndServe serves until ctx is done, then stops accepting connections
+// and returns once the requests in flight were answered.
 func (s *Server) ListenAndServe(ctx context.Context) error {
 	errChannel := make(chan error, 1)
 
@@ -205,13 +233,9 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 
 	select {
 	case <-ctx.Done():
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
-		_ = s.app.Shutdown()
-		<-shutdownCtx.Done()
-
-		return ctx.Err()
+		// streams never finish on their own, Shutdown would wait for them
+		s.stopServing()
+		return s.app.Shutdown()
 	case err := <-errChannel:
 		return err
 	}